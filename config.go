@@ -0,0 +1,140 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go-tournament/keymap"
+	"go-tournament/theme"
+	"go-tournament/tournament"
+)
+
+// config holds user settings read from the config file.
+type config struct {
+	// Keys rebinds actions by name, e.g. "setup.increase": ["+", "up"]
+	Keys map[string][]string `json:"keys"`
+
+	// Theme names the built-in or user theme to draw in
+	Theme string `json:"theme"`
+
+	// Themes defines user themes by name
+	Themes map[string]json.RawMessage `json:"themes"`
+
+	// PresentationInterval is how many seconds each presentation slide is shown
+	PresentationInterval int `json:"presentation_interval"`
+
+	// Names sets how wide the bracket draws player names; fields left out
+	// keep their defaults
+	Names tournament.NameLayout `json:"names"`
+
+	// ExportDir is where schedules and calendar feeds are written
+	ExportDir string `json:"export_dir"`
+}
+
+// configPath returns the config file to use: TOURNAMENT_CONFIG if set,
+// otherwise config.json in the user's config directory.
+func configPath() string {
+	if path := os.Getenv("TOURNAMENT_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "go-tournament", "config.json")
+}
+
+// loadConfig reads the config file at path. A missing file is an empty config.
+func loadConfig(path string) (config, error) {
+	cfg := config{Names: tournament.DefaultNameLayout}
+	if path == "" {
+		return cfg, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// keymap returns the default bindings with the config's overrides applied.
+func (c config) keymap() (keymap.Keymap, error) {
+	km := keymap.Default()
+	if err := km.Apply(c.Keys); err != nil {
+		return km, fmt.Errorf("config keys: %w", err)
+	}
+	return km, nil
+}
+
+// display returns the settings for how screens are drawn.
+func (c config) display() (display, error) {
+	d := display{
+		presentationInterval: tournament.DefaultPresentationInterval,
+		names:                c.Names,
+	}
+	if c.PresentationInterval > 0 {
+		d.presentationInterval = time.Duration(c.PresentationInterval) * time.Second
+	}
+	if c.Names.MinWidth < 1 {
+		return d, errors.New("config names: min_width must be at least 1")
+	}
+	if c.Names.MaxWidth < c.Names.MinWidth {
+		return d, fmt.Errorf("config names: max_width must be at least min_width (%d)", c.Names.MinWidth)
+	}
+	return d, nil
+}
+
+// exportDir returns the absolute directory exports are written to, so the
+// path shown after an export is unambiguous, also over SSH where files land
+// on the server.
+func (c config) exportDir() (string, error) {
+	dir := c.ExportDir
+	if dir == "" {
+		dir = defaultExportDir
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("config export_dir: %w", err)
+	}
+	return abs, nil
+}
+
+// theme returns the theme to draw in. NO_COLOR always wins, then
+// TOURNAMENT_THEME, then the config; user themes may shadow built-in ones.
+func (c config) theme() (theme.Theme, error) {
+	if os.Getenv("NO_COLOR") != "" {
+		return theme.NoColor, nil
+	}
+	name := c.Theme
+	if env := os.Getenv("TOURNAMENT_THEME"); env != "" {
+		name = env
+	}
+	if name == "" {
+		return theme.Dark, nil
+	}
+
+	if raw, ok := c.Themes[name]; ok {
+		t, err := theme.Decode(raw)
+		if err != nil {
+			return t, fmt.Errorf("theme %q: %w", name, err)
+		}
+		return t, nil
+	}
+	t, err := theme.Builtin(name)
+	if err != nil {
+		return t, fmt.Errorf("%w (built-in themes are %s)", err, strings.Join(theme.Names(), ", "))
+	}
+	return t, nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/lipgloss"
+	"go-tournament/i18n"
+	"go-tournament/keymap"
+	"go-tournament/theme"
+)
+
+var (
+	helpKeyStyle     lipgloss.Style
+	helpSectionStyle lipgloss.Style
+)
+
+// applyHelpTheme styles the help overlay in the theme's colours.
+func applyHelpTheme(t theme.Theme) {
+	helpKeyStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Info.Terminal()).
+		Align(lipgloss.Right).
+		PaddingRight(2)
+
+	helpSectionStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Text.Terminal()).
+		MarginTop(1)
+}
+
+// screenKeys returns the bindings of the current screen, as listed by the help overlay.
+func (m model) screenKeys() []key.Binding {
+	k := m.keys
+	switch m.currentScreen {
+	case ScreenLibrary:
+		return append([]key.Binding{k.List.Up, k.List.Down}, append(keymap.Bindings(k.Library), k.Global.Confirm)...)
+	case ScreenRegistry:
+		if m.registry.editing {
+			return []key.Binding{k.Registry.NextField, k.Registry.PrevField, k.Registry.Save, k.Global.Back}
+		}
+		return []key.Binding{k.List.Up, k.List.Down, k.Registry.Add, k.Registry.Edit, k.Registry.Delete,
+			k.Global.Confirm, k.Global.Back}
+	case ScreenLeaderboard:
+		return []key.Binding{k.List.Up, k.List.Down, k.Global.Back}
+	case ScreenStats:
+		return []key.Binding{k.List.Up, k.List.Down, k.Stats.Compare, k.Global.Back}
+	case ScreenMenu:
+		return append(keymap.Bindings(k.Menu), k.Global.Back)
+	case ScreenSingleElimination:
+		return m.singleElimination.HelpKeys()
+	case ScreenPresentation:
+		return m.presentation.HelpKeys()
+	default:
+		return nil
+	}
+}
+
+// renderHelp lists every binding of the current screen, then those that work everywhere.
+func (m model) renderHelp() string {
+	header := headerStyle.Render(i18n.T("⌨️  Keys"))
+
+	screen := m.screenKeys()
+	global := []key.Binding{m.keys.Global.Help, m.keys.Global.Quit, m.keys.Global.ForceQuit}
+
+	// One key column for both sections, so descriptions line up
+	width := 0
+	for _, b := range append(global, screen...) {
+		width = max(width, lipgloss.Width(b.Help().Key))
+	}
+	lines := helpRows(screen, width)
+	lines = append(lines, helpSectionStyle.Render(i18n.T("Everywhere")))
+	lines = append(lines, helpRows(global, width)...)
+
+	help := helpStyle.Render(i18n.T("%s or %s to close", m.keys.Global.Help.Help().Key, m.keys.Global.Back.Help().Key))
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, header, libraryListStyle.Render(strings.Join(lines, "\n")), help),
+	)
+}
+
+// helpRows renders enabled bindings as key and description columns, with
+// keys right-aligned to width.
+func helpRows(bindings []key.Binding, width int) []string {
+	var rows []string
+	for _, b := range bindings {
+		if !b.Enabled() {
+			continue
+		}
+		rows = append(rows, helpKeyStyle.Width(width+2).Render(b.Help().Key)+b.Help().Desc)
+	}
+	return rows
+}
@@ -0,0 +1,53 @@
+package i18n
+
+import "fmt"
+
+// englishRounds names the last rounds of a knockout, by rounds from the end.
+var englishRounds = []string{"Final", "Semifinals", "Quarterfinals"}
+
+// English is the language the messages are written in, so its catalogue
+// only holds the singular forms of messages that count something.
+var English = &Language{
+	Tag:  "en",
+	Name: "English",
+
+	isOne: func(n int) bool { return n == 1 },
+	roundName: func(players int) string {
+		if i := roundsFromEnd(players); i < len(englishRounds) {
+			return englishRounds[i]
+		}
+		return ""
+	},
+	ordinal: func(n int) string {
+		suffix := "th"
+		switch n % 100 {
+		case 11, 12, 13:
+		default:
+			switch n % 10 {
+			case 1:
+				suffix = "st"
+			case 2:
+				suffix = "nd"
+			case 3:
+				suffix = "rd"
+			}
+		}
+		return fmt.Sprintf("%d%s", n, suffix)
+	},
+
+	plurals: map[string]Plural{
+		"%3d players":                       {One: "%3d player"},
+		"%.0f after %d rated matches":       {One: "%.0f after %d rated match"},
+		"Round %d: %d matches":              {One: "Round %d: %d match"},
+		"Round %d: %d matches (%s)":         {One: "Round %d: %d match (%s)"},
+		"Round %d: %d matches (%d players)": {One: "Round %d: %d match (%d players)"},
+		"[%d players get byes]":             {One: "[%d player gets a bye]"},
+		"and %d others":                     {One: "and %d other"},
+		"Starts %s • %d min per match • %d courts": {
+			One: "Starts %s • %d min per match • %d court",
+		},
+		"📨 %d result reports awaiting approval • %s to review": {
+			One: "📨 %d result report awaiting approval • %s to review",
+		},
+	},
+}
@@ -0,0 +1,167 @@
+// Package i18n translates the text on screen. Messages are looked up by
+// their English text, so the code reads as it did before translation and a
+// message a catalogue lacks falls back to English. Formats may reorder their
+// arguments with explicit indexes such as %[2]s where a language needs to.
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"os"
+	"sort"
+	"strings"
+)
+
+// ErrUnknownLanguage is returned when no catalogue exists for a language tag.
+var ErrUnknownLanguage = errors.New("unknown language")
+
+// Plural holds the forms of a message that depends on a count. Other may be
+// left out in English, where it is the message itself.
+type Plural struct {
+	One   string
+	Other string
+}
+
+// Language is a message catalogue together with the grammar a catalogue
+// cannot express: how counts select plural forms and how knockout rounds
+// are named.
+type Language struct {
+	Tag  string // Base language tag, e.g. "ko"
+	Name string // The language's name for itself
+
+	messages map[string]string
+	plurals  map[string]Plural
+
+	// isOne reports whether a count takes the One form
+	isOne func(n int) bool
+
+	// roundName names a knockout round by the number of players in it, or
+	// returns "" when the language gives the round no name
+	roundName func(players int) string
+
+	// ordinal writes a place in the standings, e.g. "2nd"
+	ordinal func(n int) string
+}
+
+// languages holds the catalogues by tag.
+var languages = map[string]*Language{
+	English.Tag: English,
+	Korean.Tag:  Korean,
+}
+
+// current is the language text is translated into. Like the theme, it is
+// chosen once at startup and shared by every session.
+var current = English
+
+// Set translates text into l from now on.
+func Set(l *Language) {
+	current = l
+}
+
+// Current returns the language text is translated into.
+func Current() *Language {
+	return current
+}
+
+// Lookup returns the catalogue for a locale such as "ko", "ko_KR" or
+// "ko_KR.UTF-8".
+func Lookup(locale string) (*Language, error) {
+	if l, ok := languages[baseTag(locale)]; ok {
+		return l, nil
+	}
+	return nil, fmt.Errorf("%q: %w", locale, ErrUnknownLanguage)
+}
+
+// FromEnv returns the language named by the first of LC_ALL, LC_MESSAGES and
+// LANG that is set, as the C library picks it, or English when that language
+// has no catalogue.
+func FromEnv() *Language {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if locale := os.Getenv(name); locale != "" {
+			if l, err := Lookup(locale); err == nil {
+				return l
+			}
+			return English
+		}
+	}
+	return English
+}
+
+// Tags returns the tags of every catalogue, sorted.
+func Tags() []string {
+	tags := make([]string, 0, len(languages))
+	for tag := range languages {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// baseTag reduces a POSIX or BCP 47 locale to its language: "ko_KR.UTF-8"
+// and "ko-KR" are both "ko".
+func baseTag(locale string) string {
+	end := strings.IndexAny(locale, "_-.@")
+	if end >= 0 {
+		locale = locale[:end]
+	}
+	return strings.ToLower(locale)
+}
+
+// roundsFromEnd returns how many rounds a knockout round with the given
+// number of players is from the final: 0 for the final's two players.
+func roundsFromEnd(players int) int {
+	return max(bits.Len(uint(players))-2, 0)
+}
+
+// T translates text into the current language and, when args are given,
+// formats it with them.
+func T(text string, args ...any) string {
+	return current.T(text, args...)
+}
+
+// N translates text, the English plural form, picking the form the current
+// language uses for a count of n, and formats it with args.
+func N(n int, text string, args ...any) string {
+	return current.N(n, text, args...)
+}
+
+// RoundName names the knockout round with the given number of players in
+// the current language, or returns "" when it has no name.
+func RoundName(players int) string {
+	return current.roundName(players)
+}
+
+// Ordinal writes a place in the standings in the current language, e.g.
+// "2nd" or "2위".
+func Ordinal(n int) string {
+	return current.ordinal(n)
+}
+
+// T translates text into l and, when args are given, formats it with them.
+func (l *Language) T(text string, args ...any) string {
+	if translated, ok := l.messages[text]; ok {
+		text = translated
+	}
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// N translates text, the English plural form, picking the form l uses for a
+// count of n, and formats it with args.
+func (l *Language) N(n int, text string, args ...any) string {
+	plural, ok := l.plurals[text]
+	if !ok {
+		return l.T(text, args...)
+	}
+	form := text
+	if plural.Other != "" {
+		form = plural.Other
+	}
+	if l.isOne(n) && plural.One != "" {
+		form = plural.One
+	}
+	return fmt.Sprintf(form, args...)
+}
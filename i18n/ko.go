@@ -0,0 +1,305 @@
+package i18n
+
+import "fmt"
+
+// koreanRounds names the last rounds of a knockout, by rounds from the end.
+// Earlier rounds are named after the players left in them: 16강 for the
+// last sixteen, 8강 for the last eight.
+var koreanRounds = []string{"결승", "준결승"}
+
+// Korean has no grammatical number, so its counted messages need no
+// plural forms and live with the rest.
+var Korean = &Language{
+	Tag:  "ko",
+	Name: "한국어",
+
+	isOne: func(int) bool { return false },
+	roundName: func(players int) string {
+		if i := roundsFromEnd(players); i < len(koreanRounds) {
+			return koreanRounds[i]
+		}
+		return fmt.Sprintf("%d강", players)
+	},
+	ordinal: func(n int) string {
+		return fmt.Sprintf("%d위", n)
+	},
+
+	messages: map[string]string{
+		// Menu
+		"🏆 Tournament Manager 🏆": "🏆 토너먼트 매니저 🏆",
+		"Single Elimination":     "싱글 엘리미네이션",
+		"Classic bracket style\nwhere losers are\neliminated instantly": "지면 바로 탈락하는\n전통적인\n대진표 방식",
+		"Double Elimination": "더블 엘리미네이션",
+		"Players get a second\nchance in the\nloser's bracket": "패자 대진표에서\n한 번 더\n기회를 얻습니다",
+		"Round Robin": "풀리그",
+		"Everyone plays\neveryone else\nat least once": "모든 선수가\n서로 한 번 이상\n경기합니다",
+		" • click a card to select • ":                 " • 카드를 클릭해 선택 • ",
+		"📡 Spectators: %s":                             "📡 관전: %s",
+
+		// Tournament list
+		"📚 Tournaments": "📚 토너먼트",
+		"No saved tournaments yet. Press %s to start one.":           "저장된 토너먼트가 없습니다. %s 키로 새로 시작하세요.",
+		"Duplicated with a fresh draw":                               "새 추첨으로 복제했습니다",
+		"Delete %q for good? %s to confirm, any other key to cancel": "%q을(를) 영구 삭제할까요? %s 키로 확인, 다른 키는 취소",
+		"%q is open and cannot be deleted":                           "%q은(는) 열려 있어 삭제할 수 없습니다",
+		"In progress":                                                "진행 중",
+		"(archived)":                                                 "(보관됨)",
+		"%3d players":                                                "%3d명",
+		"Could not save tournament: %v":                              "토너먼트를 저장하지 못했습니다: %v",
+		"Spectator server stopped: %v":                               "관전 서버가 중지되었습니다: %v",
+
+		// Player registry
+		"👥 Player Registry":            "👥 선수 명부",
+		"New player":                   "새 선수",
+		"Player #%d":                   "선수 #%d",
+		"Name":                         "이름",
+		"Aliases":                      "별명",
+		"Club":                         "소속",
+		"Contact":                      "연락처",
+		"Separate aliases with commas": "별명은 쉼표로 구분하세요",
+		"No registered players yet. Press %s to add one.":                     "등록된 선수가 없습니다. %s 키로 추가하세요.",
+		"Remove %s from the registry? %s to confirm, any other key to cancel": "%s 선수를 명부에서 지울까요? %s 키로 확인, 다른 키는 취소",
+		"Saved %s": "%s 저장됨",
+
+		// Ratings
+		"📈 Ratings": "📈 레이팅",
+		"No rated matches yet. Matches between registered players count once played.": "레이팅에 반영된 경기가 없습니다. 등록된 선수끼리 경기하면 반영됩니다.",
+		"%.0f after %d rated matches": "%.0f (%d경기 반영)",
+		"beat":                        "승리",
+		"lost to":                     "패배",
+		"W":                           "승",
+		"L":                           "패",
+
+		// Player statistics
+		"📊 Player Statistics":                                     "📊 선수 통계",
+		"No players yet. Register players or start a tournament.": "선수가 없습니다. 선수를 등록하거나 토너먼트를 시작하세요.",
+		"on %s to stop comparing":                                 "%s 선수와 비교 끝내기",
+		"No matches yet":                                          "경기 기록이 없습니다",
+		"Tournaments: %d":                                         "출전 토너먼트: %d",
+		"Matches played: %d (%d won, %d lost)":                    "경기 수: %d (%d승 %d패)",
+		"Walkovers: %d":                                           "부전: %d",
+		"Furthest: %s in %s":                                      "최고 성적: %s (%s)",
+		"Beaten: %s":                                              "이긴 상대: %s",
+		"Recent matches":                                          "최근 경기",
+		"They have never met":                                     "맞붙은 적이 없습니다",
+		"%s leads %d-%d":                                          "%s 우세 %d-%d",
+		"Level at %d-%d":                                          "%d-%d 동률",
+		"(w/o)":                                                   "(부전)",
+		"Third place":                                             "3위 결정전",
+		"Champion":                                                "우승",
+
+		// Help overlay
+		"⌨️  Keys":          "⌨️  키",
+		"Everywhere":        "모든 화면",
+		"%s or %s to close": "%s 또는 %s 키로 닫기",
+
+		// Tournament setup
+		"🥊 Single Elimination Tournament":    "🥊 싱글 엘리미네이션 토너먼트",
+		"Participants: %d":                   "참가자: %d",
+		"(minimum: %d)":                      "(최소: %d)",
+		"(maximum: %d)":                      "(최대: %d)",
+		"Bracket Size: %d":                   "대진표 크기: %d",
+		"Rounds: %d":                         "라운드: %d",
+		"Byes: %d":                           "부전승: %d",
+		"Off":                                "끔",
+		"On":                                 "켬",
+		"Third-place match: %s":              "3위 결정전: %s",
+		"Courts: %d":                         "코트: %d",
+		"Courts: not managed":                "코트: 관리 안 함",
+		"Match duration: %d min":             "경기 시간: %d분",
+		"First matches: %s":                  "첫 경기: %s",
+		"Rest between matches: %d min":       "경기 사이 휴식: %d분",
+		"Registered players: %d of %d":       "등록 선수: %d / %d",
+		"Order picked":                       "선택한 순서",
+		"By rating":                          "레이팅 순",
+		"Seeding: %s":                        "시드 배정: %s",
+		"Round %d: %d matches":               "%d라운드: %d경기",
+		"Round %d: %d matches (%s)":          "%d라운드: %d경기 (%s)",
+		"Round %d: %d matches (%d players)":  "%d라운드: %d경기 (%d명)",
+		"[%d players get byes]":              "[%d명 부전승]",
+		"A bracket holds at most %d players": "대진표에는 최대 %d명까지 들어갑니다",
+		"Player %d":                          "선수 %d",
+
+		// Bracket
+		"Round %d":               "%d라운드",
+		"R%d":                    "%dR",
+		"3rd Place":              "3위 결정전",
+		"3rd":                    "3위",
+		"TBD":                    "미정",
+		"bye":                    "부전승",
+		"Ct %d":                  "%d코트",
+		"Court %d":               "%d번 코트",
+		"%s vs %s":               "%s 대 %s",
+		"vs":                     "대",
+		"Scheduled":              "예정",
+		"Ready":                  "준비",
+		"In Progress":            "진행 중",
+		"Completed":              "완료",
+		"Walkover":               "부전승",
+		"Bye":                    "부전승",
+		"Full":                   "전체",
+		"Compact":                "간략",
+		"Minimap":                "미니맵",
+		"Zoom: %s":               "확대: %s",
+		" • columns %d–%d of %d": " • 열 %d–%d / %d",
+		" • rows %d–%d of %d":    " • 행 %d–%d / %d",
+		"Tournament with %d participants • %d rounds • %d in progress • %d ready": "참가자 %d명 • %d라운드 • 진행 중 %d • 준비 %d",
+		"📨 %d result reports awaiting approval • %s to review":                    "📨 승인 대기 중인 결과 보고 %d건 • %s 키로 확인",
+		" • the mouse wheel scrolls • click a match to record it":                 " • 마우스 휠로 스크롤 • 경기를 클릭해 결과 입력",
+		"Match is %s and cannot be started":                                       "경기가 %s 상태라 시작할 수 없습니다",
+		"Schedule written to %s":                                                  "일정을 %s에 저장했습니다",
+		"Calendars written to %s/ and kept up to date":                            "캘린더를 %s/에 저장했고 계속 갱신합니다",
+		"Result was entered by another organizer":                                 "다른 운영자가 결과를 입력했습니다",
+		"Now Playing":              "진행 중인 경기",
+		"free":                     "비어 있음",
+		"Up Next":                  "다음 경기",
+		"waiting for results":      "결과 대기 중",
+		"+%d more":                 "외 %d경기",
+		"Find player: %s▏":         "선수 찾기: %s▏",
+		"%d of %d: %s":             "%d / %d: %s",
+		"Nobody matches":           "일치하는 선수가 없습니다",
+		"Path of %s • %s to clear": "%s의 경로 • %s 키로 해제",
+		"Type a name • ":           "이름을 입력하세요 • ",
+		"Out":                      "탈락",
+		"and %d others":            "외 %d명",
+
+		// Printed schedule and calendars
+		"Tournament Schedule":                      "토너먼트 일정",
+		"Starts %s • %d min per match • %d courts": "%s 시작 • 경기당 %d분 • 코트 %d개",
+		"Time":            "시간",
+		"Court":           "코트",
+		"Round":           "라운드",
+		"Match":           "경기",
+		"Tournament":      "토너먼트",
+		"Tournament – %s": "토너먼트 – %s",
+		"Status: %s":      "상태: %s",
+		"Winner: %s":      "승자: %s",
+
+		// Result entry
+		"🥊 Record Result": "🥊 결과 입력",
+		"%s • Match %d":   "%s • %d경기",
+		"%s\n\nSeed %d":   "%s\n\n%d번 시드",
+
+		// Final standings
+		"🥊 Final Standings":    "🥊 최종 순위",
+		"🏆  CHAMPION  🏆\n\n%s": "🏆  우승  🏆\n\n%s",
+		"seed %d":              "%d번 시드",
+		"%s (on)":              "%s (켬)",
+		"%s (off)":             "%s (끔)",
+
+		// Result reports
+		"📨 Result Reports":        "📨 결과 보고",
+		"No reports waiting":      "대기 중인 보고가 없습니다",
+		"Match %d":                "%d번 경기",
+		"%s  %s • %s says %s won": "%s  %s • %s: %s 승리",
+		"⚠ conflict":              "⚠ 충돌",
+
+		// Participant picker
+		"👥 Pick Participants": "👥 참가자 선택",
+		"Search: %s▏":         "검색: %s▏",
+		"No registered players yet. Type a name to add one.": "등록된 선수가 없습니다. 이름을 입력해 추가하세요.",
+		"No match. %s to register %q":                        "일치하는 선수가 없습니다. %s 키로 %q 등록",
+		"No players picked":                                  "선택한 선수가 없습니다",
+		"Seeds: %s":                                          "시드: %s",
+		"Type to search • ":                                  "입력해서 검색 • ",
+
+		// Players of a drawn bracket
+		"✏️ Players":      "✏️ 선수 명단",
+		"Late entry: %s▏": "추가 참가자: %s▏",
+		"New name: %s▏":   "새 이름: %s▏",
+		"Play has started: players can only be renamed": "경기가 시작되어 이름만 바꿀 수 있습니다",
+
+		// Presentation
+		"WAITING":              "대기 중",
+		"Waiting for the draw": "대진 추첨을 기다리는 중",
+		"UP NEXT":              "다음 경기",
+		"CHAMPION":             "우승",
+		"Ready to Play":        "경기 준비 완료",
+		"Waiting for results":  "결과를 기다리는 중",
+		"%d of %d decided":     "%d / %d 경기 종료",
+		"Now playing • %s":     "진행 중 • %s",
+		" • page %d of %d":     " • %d / %d 페이지",
+		"paused":               "일시 정지",
+
+		// Key help
+		"quit":                             "종료",
+		"quit, even while typing":          "입력 중에도 종료",
+		"back":                             "뒤로",
+		"help":                             "도움말",
+		"confirm":                          "확인",
+		"up":                               "위로",
+		"down":                             "아래로",
+		"previous format":                  "이전 방식",
+		"next format":                      "다음 방식",
+		"select":                           "선택",
+		"open":                             "열기",
+		"new tournament":                   "새 토너먼트",
+		"duplicate":                        "복제",
+		"archive/restore":                  "보관/복원",
+		"show/hide archived":               "보관함 보기/숨기기",
+		"delete":                           "삭제",
+		"players":                          "선수",
+		"ratings":                          "레이팅",
+		"stats":                            "통계",
+		"add":                              "추가",
+		"edit":                             "수정",
+		"next field":                       "다음 항목",
+		"previous field":                   "이전 항목",
+		"save":                             "저장",
+		"compare with another player":      "다른 선수와 비교",
+		"more players":                     "참가자 늘리기",
+		"fewer players":                    "참가자 줄이기",
+		"pick players":                     "선수 선택",
+		"seed by rating":                   "레이팅 순 시드",
+		"third-place match":                "3위 결정전",
+		"courts":                           "코트",
+		"match duration":                   "경기 시간",
+		"start later":                      "시작 늦추기",
+		"start earlier":                    "시작 앞당기기",
+		"rest between matches":             "경기 사이 휴식",
+		"continue":                         "계속",
+		"pick, or register the typed name": "선택, 또는 입력한 이름 등록",
+		"select up":                        "위 경기 선택",
+		"select down":                      "아래 경기 선택",
+		"previous round":                   "이전 라운드",
+		"next round":                       "다음 라운드",
+		"next match":                       "다음 경기",
+		"start/stop match":                 "경기 시작/중지",
+		"record result":                    "결과 입력",
+		"results":                          "결과",
+		"export schedule":                  "일정 내보내기",
+		"export calendars":                 "캘린더 내보내기",
+		"reported results":                 "보고된 결과",
+		"scroll up":                        "위로 스크롤",
+		"scroll down":                      "아래로 스크롤",
+		"scroll left":                      "왼쪽으로 스크롤",
+		"scroll right":                     "오른쪽으로 스크롤",
+		"zoom":                             "확대/축소",
+		"later rounds":                     "뒤 라운드",
+		"earlier rounds":                   "앞 라운드",
+		"big-screen presentation":          "대형 화면 발표",
+		"first player wins":                "첫 번째 선수 승리",
+		"second player wins":               "두 번째 선수 승리",
+		"walkover":                         "부전승",
+		"approve":                          "승인",
+		"reject":                           "거절",
+		"tokens/reports":                   "토큰/보고",
+		"seed tiebreak":                    "시드로 동순위 가르기",
+		"next slide":                       "다음 슬라이드",
+		"previous slide":                   "이전 슬라이드",
+		"pause/resume":                     "일시 정지/재개",
+		"find player":                      "선수 찾기",
+		"next player found":                "다음 검색 결과",
+		"previous player found":            "이전 검색 결과",
+		"keep highlighting":                "강조 유지",
+		"follow a player":                  "선수 경로 따라가기",
+		"edit players":                     "선수 명단 수정",
+		"add late entry":                   "참가자 추가",
+		"withdraw player":                  "선수 기권 처리",
+		"rename":                           "이름 바꾸기",
+		"move up a seed":                   "시드 올리기",
+		"move down a seed":                 "시드 내리기",
+		"redraw at random":                 "무작위 재추첨",
+		"save name":                        "이름 저장",
+	},
+}
@@ -0,0 +1,343 @@
+// Package keymap defines the key bindings of every screen in one place, so
+// they can be changed from the config file and listed by the help overlay.
+package keymap
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"go-tournament/i18n"
+)
+
+// GlobalKeys work on every screen that is not taking typed text.
+type GlobalKeys struct {
+	Quit      key.Binding
+	ForceQuit key.Binding // Quits even while typing
+	Back      key.Binding
+	Help      key.Binding
+	Confirm   key.Binding // Answers yes to a confirmation prompt
+}
+
+// ListKeys move the cursor on the list screens.
+type ListKeys struct {
+	Up   key.Binding
+	Down key.Binding
+}
+
+// MenuKeys pick a tournament format.
+type MenuKeys struct {
+	Left   key.Binding
+	Right  key.Binding
+	Select key.Binding
+}
+
+// LibraryKeys manage saved tournaments.
+type LibraryKeys struct {
+	Open         key.Binding
+	New          key.Binding
+	Duplicate    key.Binding
+	Archive      key.Binding
+	ShowArchived key.Binding
+	Delete       key.Binding
+	Players      key.Binding
+	Ratings      key.Binding
+	Stats        key.Binding
+}
+
+// RegistryKeys manage the player registry and its edit form.
+type RegistryKeys struct {
+	Add       key.Binding
+	Edit      key.Binding
+	Delete    key.Binding
+	NextField key.Binding
+	PrevField key.Binding
+	Save      key.Binding
+}
+
+// StatsKeys work on the player statistics screen.
+type StatsKeys struct {
+	Compare key.Binding
+}
+
+// SetupKeys configure a new bracket.
+type SetupKeys struct {
+	Increase     key.Binding
+	Decrease     key.Binding
+	PickPlayers  key.Binding
+	SeedByRating key.Binding
+	ThirdPlace   key.Binding
+	Courts       key.Binding
+	Duration     key.Binding
+	StartLater   key.Binding
+	StartEarlier key.Binding
+	Rest         key.Binding
+	Start        key.Binding
+}
+
+// PickerKeys work on the participant picker. Letters are typed into the
+// search, so only non-printing keys should be bound here.
+type PickerKeys struct {
+	Up   key.Binding
+	Down key.Binding
+	Pick key.Binding
+}
+
+// BracketKeys run the bracket view.
+type BracketKeys struct {
+	Up             key.Binding
+	Down           key.Binding
+	Left           key.Binding
+	Right          key.Binding
+	NextMatch      key.Binding
+	StartStop      key.Binding
+	Record         key.Binding
+	Results        key.Binding
+	ExportSchedule key.Binding
+	Calendars      key.Binding
+	Reports        key.Binding
+	PageUp         key.Binding
+	PageDown       key.Binding
+	PageLeft       key.Binding
+	PageRight      key.Binding
+	Zoom           key.Binding
+	NextRound      key.Binding
+	PrevRound      key.Binding
+	Present        key.Binding
+	Find           key.Binding
+	Follow         key.Binding // Highlights each player of the selected match in turn
+	EditPlayers    key.Binding
+}
+
+// FieldKeys change the players of a drawn bracket. Names are typed in, so
+// only Save and non-printing keys work while one is being typed.
+type FieldKeys struct {
+	Add      key.Binding
+	Remove   key.Binding
+	Rename   key.Binding
+	SeedUp   key.Binding
+	SeedDown key.Binding
+	Redraw   key.Binding
+	Save     key.Binding
+}
+
+// FindKeys work while finding a player in the bracket view. Letters are typed
+// into the search, so only non-printing keys should be bound here.
+type FindKeys struct {
+	Next key.Binding
+	Prev key.Binding
+	Done key.Binding
+}
+
+// EntryKeys record a match result.
+type EntryKeys struct {
+	Player1  key.Binding
+	Player2  key.Binding
+	Confirm  key.Binding
+	Walkover key.Binding
+}
+
+// ReportsKeys review results reported by players.
+type ReportsKeys struct {
+	Approve    key.Binding
+	Reject     key.Binding
+	ShowTokens key.Binding
+}
+
+// PresentationKeys step through the big-screen slides.
+type PresentationKeys struct {
+	Next  key.Binding
+	Prev  key.Binding
+	Pause key.Binding
+}
+
+// ResultsKeys work on the final standings.
+type ResultsKeys struct {
+	SeedTiebreak key.Binding
+}
+
+// Keymap holds every binding, grouped by the screen that uses it.
+type Keymap struct {
+	Global       GlobalKeys
+	List         ListKeys
+	Menu         MenuKeys
+	Library      LibraryKeys
+	Registry     RegistryKeys
+	Stats        StatsKeys
+	Setup        SetupKeys
+	Picker       PickerKeys
+	Bracket      BracketKeys
+	Field        FieldKeys
+	Find         FindKeys
+	Entry        EntryKeys
+	Reports      ReportsKeys
+	Results      ResultsKeys
+	Presentation PresentationKeys
+}
+
+// Default returns the built-in bindings.
+func Default() Keymap {
+	return Keymap{
+		Global: GlobalKeys{
+			Quit:      bind("quit", "q"),
+			ForceQuit: bind("quit, even while typing", "ctrl+c"),
+			Back:      bind("back", "esc"),
+			Help:      bind("help", "?"),
+			Confirm:   bind("confirm", "y"),
+		},
+		List: ListKeys{
+			Up:   bind("up", "up", "k"),
+			Down: bind("down", "down", "j"),
+		},
+		Menu: MenuKeys{
+			Left:   bind("previous format", "left", "h"),
+			Right:  bind("next format", "right", "l"),
+			Select: bind("select", "enter"),
+		},
+		Library: LibraryKeys{
+			Open:         bind("open", "enter"),
+			New:          bind("new tournament", "n"),
+			Duplicate:    bind("duplicate", "c"),
+			Archive:      bind("archive/restore", "a"),
+			ShowArchived: bind("show/hide archived", "tab"),
+			Delete:       bind("delete", "d"),
+			Players:      bind("players", "p"),
+			Ratings:      bind("ratings", "r"),
+			Stats:        bind("stats", "s"),
+		},
+		Registry: RegistryKeys{
+			Add:       bind("add", "a"),
+			Edit:      bind("edit", "enter", "e"),
+			Delete:    bind("delete", "d"),
+			NextField: bind("next field", "tab", "down"),
+			PrevField: bind("previous field", "shift+tab", "up"),
+			Save:      bind("save", "enter"),
+		},
+		Stats: StatsKeys{
+			Compare: bind("compare with another player", "enter"),
+		},
+		Setup: SetupKeys{
+			Increase:     bind("more players", "+", "k", "up"),
+			Decrease:     bind("fewer players", "-", "j", "down"),
+			PickPlayers:  bind("pick players", "p"),
+			SeedByRating: bind("seed by rating", "r"),
+			ThirdPlace:   bind("third-place match", "t"),
+			Courts:       bind("courts", "c"),
+			Duration:     bind("match duration", "d"),
+			StartLater:   bind("start later", "s"),
+			StartEarlier: bind("start earlier", "S"),
+			Rest:         bind("rest between matches", "b"),
+			Start:        bind("continue", "enter"),
+		},
+		Picker: PickerKeys{
+			Up:   bind("up", "up"),
+			Down: bind("down", "down"),
+			Pick: bind("pick, or register the typed name", "enter"),
+		},
+		Bracket: BracketKeys{
+			Up:             bind("select up", "up", "k"),
+			Down:           bind("select down", "down", "j"),
+			Left:           bind("previous round", "left", "h"),
+			Right:          bind("next round", "right", "l"),
+			NextMatch:      bind("next match", "n"),
+			StartStop:      bind("start/stop match", "s"),
+			Record:         bind("record result", "enter"),
+			Results:        bind("results", "r"),
+			ExportSchedule: bind("export schedule", "p"),
+			Calendars:      bind("export calendars", "i"),
+			Reports:        bind("reported results", "v"),
+			PageUp:         bind("scroll up", "pgup"),
+			PageDown:       bind("scroll down", "pgdown"),
+			PageLeft:       bind("scroll left", "shift+left", "<"),
+			PageRight:      bind("scroll right", "shift+right", ">"),
+			Zoom:           bind("zoom", "z"),
+			NextRound:      bind("later rounds", "tab"),
+			PrevRound:      bind("earlier rounds", "shift+tab"),
+			Present:        bind("big-screen presentation", "b"),
+			Find:           bind("find player", "/"),
+			Follow:         bind("follow a player", "t"),
+			EditPlayers:    bind("edit players", "e"),
+		},
+		Field: FieldKeys{
+			Add:      bind("add late entry", "a"),
+			Remove:   bind("withdraw player", "x"),
+			Rename:   bind("rename", "r"),
+			SeedUp:   bind("move up a seed", "K", "shift+up"),
+			SeedDown: bind("move down a seed", "J", "shift+down"),
+			Redraw:   bind("redraw at random", "R"),
+			Save:     bind("save name", "enter"),
+		},
+		Find: FindKeys{
+			Next: bind("next player found", "down", "tab"),
+			Prev: bind("previous player found", "up", "shift+tab"),
+			Done: bind("keep highlighting", "enter"),
+		},
+		Entry: EntryKeys{
+			Player1:  bind("first player wins", "left", "h", "1"),
+			Player2:  bind("second player wins", "right", "l", "2"),
+			Confirm:  bind("confirm", "enter"),
+			Walkover: bind("walkover", "w"),
+		},
+		Reports: ReportsKeys{
+			Approve:    bind("approve", "a"),
+			Reject:     bind("reject", "x"),
+			ShowTokens: bind("tokens/reports", "t"),
+		},
+		Results: ResultsKeys{
+			SeedTiebreak: bind("seed tiebreak", "s"),
+		},
+		Presentation: PresentationKeys{
+			Next:  bind("next slide", "right", "l"),
+			Prev:  bind("previous slide", "left", "h"),
+			Pause: bind("pause/resume", " "),
+		},
+	}
+}
+
+// bind creates a binding whose help shows every key, with its description
+// in the current language.
+func bind(desc string, keys ...string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(KeyNames(keys), i18n.T(desc)))
+}
+
+// keyNames are the names shown in help for keys that read poorly as typed.
+var keyNames = map[string]string{
+	"up":          "↑",
+	"down":        "↓",
+	"left":        "←",
+	"right":       "→",
+	"enter":       "Enter",
+	"esc":         "Esc",
+	"tab":         "Tab",
+	"shift+tab":   "Shift+Tab",
+	"shift+up":    "Shift+↑",
+	"shift+down":  "Shift+↓",
+	"shift+left":  "Shift+←",
+	"shift+right": "Shift+→",
+	"pgup":        "PgUp",
+	"pgdown":      "PgDn",
+	"ctrl+c":      "Ctrl+C",
+	" ":           "Space",
+}
+
+// KeyNames returns keys as shown in help, e.g. "↑/k".
+func KeyNames(keys []string) string {
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		if name, ok := keyNames[k]; ok {
+			k = name
+		}
+		names[i] = k
+	}
+	return strings.Join(names, "/")
+}
+
+// ShortHelp renders bindings as a single help line, skipping disabled ones.
+func ShortHelp(bindings ...key.Binding) string {
+	var parts []string
+	for _, b := range bindings {
+		if b.Enabled() {
+			parts = append(parts, b.Help().Key+" "+b.Help().Desc)
+		}
+	}
+	return strings.Join(parts, " • ")
+}
@@ -0,0 +1,101 @@
+package keymap
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// ErrUnknownBinding is returned when an override names a binding that does not exist.
+var ErrUnknownBinding = errors.New("unknown key binding")
+
+// Override rebinds the named binding, e.g. "setup.increase", to keys. Names
+// are the group and binding fields in snake case. No keys unbind it.
+func (k *Keymap) Override(name string, keys []string) error {
+	b, ok := k.bindings()[name]
+	if !ok {
+		return fmt.Errorf("%q: %w", name, ErrUnknownBinding)
+	}
+	if len(keys) == 0 {
+		b.SetEnabled(false)
+		return nil
+	}
+	b.SetKeys(keys...)
+	b.SetHelp(KeyNames(keys), b.Help().Desc)
+	b.SetEnabled(true)
+	return nil
+}
+
+// Apply overrides every named binding, as read from the config file. Names
+// are applied in order so the first unknown one is always the one reported.
+func (k *Keymap) Apply(overrides map[string][]string) error {
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := k.Override(name, overrides[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Names returns the name of every binding, sorted.
+func (k *Keymap) Names() []string {
+	var names []string
+	for name := range k.bindings() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// bindings returns every binding by name. The names come from the struct
+// fields, so a binding added to a group can be overridden without more code.
+func (k *Keymap) bindings() map[string]*key.Binding {
+	bindings := make(map[string]*key.Binding)
+	groups := reflect.ValueOf(k).Elem()
+	for i := 0; i < groups.NumField(); i++ {
+		group := groups.Field(i)
+		prefix := snakeCase(groups.Type().Field(i).Name)
+		for j := 0; j < group.NumField(); j++ {
+			name := prefix + "." + snakeCase(group.Type().Field(j).Name)
+			bindings[name] = group.Field(j).Addr().Interface().(*key.Binding)
+		}
+	}
+	return bindings
+}
+
+// Bindings returns the bindings of a group, such as km.Bracket, in field order.
+func Bindings(group any) []key.Binding {
+	v := reflect.ValueOf(group)
+	bindings := make([]key.Binding, 0, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		if b, ok := v.Field(i).Interface().(key.Binding); ok {
+			bindings = append(bindings, b)
+		}
+	}
+	return bindings
+}
+
+// snakeCase turns a Go field name such as ExportSchedule into export_schedule.
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
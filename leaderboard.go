@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go-tournament/i18n"
+	"go-tournament/keymap"
+	"go-tournament/storage"
+	"go-tournament/theme"
+	"go-tournament/tournament"
+)
+
+// leaderboardModel ranks registered players by rating over every saved
+// tournament, with the recent rating history of the selected player.
+type leaderboardModel struct {
+	db        *storage.DB
+	board     []tournament.PlayerRating
+	names     map[int64]string // Registry names by ID
+	selected  int
+	statusMsg string // Feedback from the last action
+	keys      keymap.Keymap
+	width     int
+	height    int
+}
+
+var (
+	leaderboardGainStyle lipgloss.Style
+	leaderboardLossStyle lipgloss.Style
+)
+
+// applyLeaderboardTheme styles rating changes in the theme's colours.
+func applyLeaderboardTheme(t theme.Theme) {
+	leaderboardGainStyle = lipgloss.NewStyle().
+		Foreground(t.Info.Terminal())
+
+	leaderboardLossStyle = lipgloss.NewStyle().
+		Foreground(t.Danger.Terminal())
+}
+
+// leaderboardHistoryRows is how many recent matches are shown for the selected player.
+const leaderboardHistoryRows = 10
+
+func newLeaderboardModel(db *storage.DB, keys keymap.Keymap) leaderboardModel {
+	m := leaderboardModel{db: db, keys: keys}
+	m.reload()
+	return m
+}
+
+// reload replays ratings from the database, keeping the cursor in range.
+func (m *leaderboardModel) reload() {
+	ratings, err := m.db.Ratings()
+	if err != nil {
+		m.statusMsg = err.Error()
+		return
+	}
+	players, err := m.db.RegisteredPlayers()
+	if err != nil {
+		m.statusMsg = err.Error()
+		return
+	}
+
+	m.names = make(map[int64]string, len(players))
+	for _, p := range players {
+		m.names[p.ID] = p.Name
+	}
+	m.board = ratings.Leaderboard()
+	if m.selected >= len(m.board) {
+		m.selected = max(len(m.board)-1, 0)
+	}
+}
+
+func (m leaderboardModel) Update(msg tea.Msg) (leaderboardModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		m.statusMsg = ""
+		switch {
+		case key.Matches(msg, m.keys.List.Up):
+			if m.selected > 0 {
+				m.selected--
+			}
+		case key.Matches(msg, m.keys.List.Down):
+			if m.selected < len(m.board)-1 {
+				m.selected++
+			}
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	}
+	return m, nil
+}
+
+func (m leaderboardModel) View() string {
+	header := headerStyle.Render(i18n.T("📈 Ratings"))
+
+	var body string
+	if len(m.board) == 0 {
+		body = libraryListStyle.Render(libraryArchivedStyle.Render(
+			i18n.T("No rated matches yet. Matches between registered players count once played.")))
+	} else {
+		// Scroll so the cursor stays visible
+		first := 0
+		if m.selected >= libraryVisibleRows {
+			first = m.selected - libraryVisibleRows + 1
+		}
+		last := min(first+libraryVisibleRows, len(m.board))
+
+		var rows []string
+		for i := first; i < last; i++ {
+			rows = append(rows, m.renderRow(i, i == m.selected))
+		}
+		body = lipgloss.JoinHorizontal(lipgloss.Top,
+			libraryListStyle.Render(strings.Join(rows, "\n")),
+			"  ",
+			libraryListStyle.Render(m.renderHistory(m.board[m.selected])),
+		)
+	}
+
+	help := helpStyle.Render(keymap.ShortHelp(m.keys.List.Up, m.keys.List.Down,
+		m.keys.Global.Back, m.keys.Global.Help, m.keys.Global.Quit))
+
+	sections := []string{header, body}
+	if m.statusMsg != "" {
+		sections = append(sections, libraryStatusStyle.Render(m.statusMsg))
+	}
+	sections = append(sections, help)
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, sections...),
+	)
+}
+
+// renderRow renders one leaderboard entry: rank, name, rating, record and form.
+func (m leaderboardModel) renderRow(rank int, selected bool) string {
+	p := m.board[rank]
+	row := fmt.Sprintf("%3d. %s %6.0f  %3d-%-3d %s",
+		rank+1, fit(m.name(p.RegistryID), 22), p.Rating, p.Wins, p.Losses, form(p.History, 5))
+	if selected {
+		return librarySelectedRowStyle.Render(row)
+	}
+	return libraryRowStyle.Render(row)
+}
+
+// renderHistory lists a player's most recent rated matches, newest first.
+func (m leaderboardModel) renderHistory(p tournament.PlayerRating) string {
+	lines := []string{
+		titleStyle.Render(m.name(p.RegistryID)),
+		i18n.N(p.Played(), "%.0f after %d rated matches", p.Rating, p.Played()),
+		"",
+	}
+	for i := len(p.History) - 1; i >= 0 && i >= len(p.History)-leaderboardHistoryRows; i-- {
+		change := p.History[i]
+		result := i18n.T("lost to")
+		if change.Won {
+			result = i18n.T("beat")
+		}
+		delta := leaderboardLossStyle.Render(fmt.Sprintf("%+5.0f", change.Delta))
+		if change.Delta >= 0 {
+			delta = leaderboardGainStyle.Render(fmt.Sprintf("%+5.0f", change.Delta))
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s %s %s",
+			change.Result.At.Format("2 Jan"), delta, fit(result, 7),
+			fit(m.name(change.OpponentID), 18), truncate(change.Result.Tournament, 20)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// name returns a registered player's name, falling back to their ID.
+func (m leaderboardModel) name(registryID int64) string {
+	if name, ok := m.names[registryID]; ok {
+		return name
+	}
+	return i18n.T("Player #%d", registryID)
+}
+
+// form summarises the last n results as W and L, most recent last.
+func form(history []tournament.RatingChange, n int) string {
+	var b strings.Builder
+	for _, change := range history[max(len(history)-n, 0):] {
+		if change.Won {
+			b.WriteString(i18n.T("W"))
+		} else {
+			b.WriteString(i18n.T("L"))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"go-tournament/i18n"
+	"go-tournament/keymap"
+	"go-tournament/storage"
+	"go-tournament/theme"
+	"go-tournament/tournament"
+)
+
+// libraryModel lists saved tournaments so they can be reopened, duplicated,
+// archived or deleted before starting a new one from the format menu.
+type libraryModel struct {
+	db            *storage.DB
+	store         *tournament.Store // Holds the open tournament, which cannot be deleted
+	tournaments   []storage.Summary
+	selected      int
+	showArchived  bool   // Include archived tournaments in the list
+	confirmDelete bool   // Waiting for y to delete the selected tournament
+	statusMsg     string // Feedback from the last action
+	keys          keymap.Keymap
+	width         int
+	height        int
+}
+
+// openTournamentMsg asks the app to load a saved tournament and show it.
+type openTournamentMsg struct {
+	id int64
+}
+
+var (
+	libraryRowStyle         lipgloss.Style
+	librarySelectedRowStyle lipgloss.Style
+	libraryArchivedStyle    lipgloss.Style
+	libraryListStyle        lipgloss.Style
+	libraryStatusStyle      lipgloss.Style
+)
+
+// applyLibraryTheme styles the tournament list, and the rows, panels and
+// status lines the other list screens share, in the theme's colours.
+func applyLibraryTheme(t theme.Theme) {
+	libraryRowStyle = lipgloss.NewStyle().
+		Foreground(t.Text.Terminal()).
+		Padding(0, 1)
+
+	librarySelectedRowStyle = libraryRowStyle.
+		Bold(true).
+		Foreground(t.Selected.Terminal()).
+		Background(t.SelectedBg.Terminal()).
+		Reverse(t.Reverse)
+
+	libraryArchivedStyle = libraryRowStyle.
+		Foreground(t.Muted.Terminal())
+
+	libraryListStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Border.Terminal()).
+		Padding(1, 2)
+
+	libraryStatusStyle = lipgloss.NewStyle().
+		Foreground(t.Warning.Terminal()).
+		Italic(true).
+		Align(lipgloss.Center).
+		MarginTop(1)
+}
+
+// libraryVisibleRows is how many tournaments are listed at once.
+const libraryVisibleRows = 12
+
+func newLibraryModel(db *storage.DB, store *tournament.Store, keys keymap.Keymap) libraryModel {
+	m := libraryModel{db: db, store: store, keys: keys}
+	m.reload()
+	return m
+}
+
+// reload re-reads the tournament list, keeping the cursor in range.
+func (m *libraryModel) reload() {
+	tournaments, err := m.db.List(m.showArchived)
+	if err != nil {
+		m.statusMsg = err.Error()
+		return
+	}
+	m.tournaments = tournaments
+	if m.selected >= len(m.tournaments) {
+		m.selected = max(len(m.tournaments)-1, 0)
+	}
+}
+
+// isOpen reports whether a saved tournament is the one open in the store.
+// The recorder keeps saving the open tournament under its ID, so deleting it
+// would make every later save fail.
+func (m libraryModel) isOpen(id int64) bool {
+	open := false
+	m.store.View(func(t tournament.Tournament) {
+		open = t.Bracket != nil && t.ID == id
+	})
+	return open
+}
+
+// selectID moves the cursor to the tournament with the given ID, if listed.
+func (m *libraryModel) selectID(id int64) {
+	for i, t := range m.tournaments {
+		if t.ID == id {
+			m.selected = i
+		}
+	}
+}
+
+func (m libraryModel) Update(msg tea.Msg) (libraryModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.confirmDelete {
+			m.confirmDelete = false
+			m.statusMsg = ""
+			if key.Matches(msg, m.keys.Global.Confirm) && m.selected < len(m.tournaments) {
+				t := m.tournaments[m.selected]
+				if m.isOpen(t.ID) {
+					// Opened in another session while waiting for confirmation
+					m.statusMsg = i18n.T("%q is open and cannot be deleted", t.Name)
+					return m, nil
+				}
+				if err := m.db.Delete(t.ID); err != nil {
+					m.statusMsg = err.Error()
+				}
+				m.reload()
+			}
+			return m, nil
+		}
+
+		m.statusMsg = ""
+		switch {
+		case key.Matches(msg, m.keys.List.Up):
+			if m.selected > 0 {
+				m.selected--
+			}
+		case key.Matches(msg, m.keys.List.Down):
+			if m.selected < len(m.tournaments)-1 {
+				m.selected++
+			}
+		case key.Matches(msg, m.keys.Library.Open):
+			if m.selected < len(m.tournaments) {
+				id := m.tournaments[m.selected].ID
+				return m, func() tea.Msg {
+					return openTournamentMsg{id: id}
+				}
+			}
+		case key.Matches(msg, m.keys.Library.New):
+			return m, func() tea.Msg {
+				return screenChangeMsg{screen: ScreenMenu}
+			}
+		case key.Matches(msg, m.keys.Library.Players):
+			return m, func() tea.Msg {
+				return screenChangeMsg{screen: ScreenRegistry}
+			}
+		case key.Matches(msg, m.keys.Library.Ratings):
+			return m, func() tea.Msg {
+				return screenChangeMsg{screen: ScreenLeaderboard}
+			}
+		case key.Matches(msg, m.keys.Library.Stats):
+			return m, func() tea.Msg {
+				return screenChangeMsg{screen: ScreenStats}
+			}
+		case key.Matches(msg, m.keys.Library.Duplicate):
+			if m.selected < len(m.tournaments) {
+				id, err := m.db.Duplicate(m.tournaments[m.selected].ID)
+				if err != nil {
+					m.statusMsg = err.Error()
+					break
+				}
+				m.reload()
+				m.selectID(id)
+				m.statusMsg = i18n.T("Duplicated with a fresh draw")
+			}
+		case key.Matches(msg, m.keys.Library.Archive):
+			if m.selected < len(m.tournaments) {
+				t := m.tournaments[m.selected]
+				if err := m.db.SetArchived(t.ID, !t.IsArchived()); err != nil {
+					m.statusMsg = err.Error()
+				}
+				m.reload()
+			}
+		case key.Matches(msg, m.keys.Library.ShowArchived):
+			m.showArchived = !m.showArchived
+			m.reload()
+		case key.Matches(msg, m.keys.Library.Delete):
+			if m.selected < len(m.tournaments) {
+				t := m.tournaments[m.selected]
+				if m.isOpen(t.ID) {
+					m.statusMsg = i18n.T("%q is open and cannot be deleted", t.Name)
+					break
+				}
+				m.confirmDelete = true
+				m.statusMsg = i18n.T("Delete %q for good? %s to confirm, any other key to cancel",
+					t.Name, m.keys.Global.Confirm.Help().Key)
+			}
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	}
+	return m, nil
+}
+
+func (m libraryModel) View() string {
+	header := headerStyle.Render(i18n.T("📚 Tournaments"))
+
+	var list string
+	if len(m.tournaments) == 0 {
+		list = libraryArchivedStyle.Render(i18n.T("No saved tournaments yet. Press %s to start one.",
+			m.keys.Library.New.Help().Key))
+	} else {
+		// Scroll so the cursor stays visible
+		first := 0
+		if m.selected >= libraryVisibleRows {
+			first = m.selected - libraryVisibleRows + 1
+		}
+		last := min(first+libraryVisibleRows, len(m.tournaments))
+
+		var rows []string
+		for i := first; i < last; i++ {
+			rows = append(rows, m.renderRow(m.tournaments[i], i == m.selected))
+		}
+		list = strings.Join(rows, "\n")
+	}
+
+	help := helpStyle.Render(keymap.ShortHelp(m.keys.Library.Open, m.keys.Library.New,
+		m.keys.Library.Delete, m.keys.Library.Players, m.keys.Library.Ratings, m.keys.Library.Stats,
+		m.keys.Global.Help, m.keys.Global.Quit))
+
+	sections := []string{header, libraryListStyle.Render(list)}
+	if m.statusMsg != "" {
+		sections = append(sections, libraryStatusStyle.Render(m.statusMsg))
+	}
+	sections = append(sections, help)
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, sections...),
+	)
+}
+
+// renderRow renders one tournament: name, size, outcome and last change.
+func (m libraryModel) renderRow(t storage.Summary, selected bool) string {
+	outcome := i18n.T("In progress")
+	if t.Champion != "" {
+		outcome = "🏆 " + t.Champion
+	}
+	name := t.Name
+	if t.IsArchived() {
+		name += " " + i18n.T("(archived)")
+	}
+
+	row := fit(name, 28) + " " + fit(i18n.N(t.Players, "%3d players", t.Players), 11) + "  " +
+		fit(outcome, 20) + " " + t.UpdatedAt.Format("2 Jan 15:04")
+
+	switch {
+	case selected:
+		return librarySelectedRowStyle.Render(row)
+	case t.IsArchived():
+		return libraryArchivedStyle.Render(row)
+	default:
+		return libraryRowStyle.Render(row)
+	}
+}
+
+// truncate shortens s to width display columns, so wide characters count
+// double, marking the cut with an ellipsis.
+func truncate(s string, width int) string {
+	return ansi.Truncate(s, width, "…")
+}
+
+// fit truncates s to width columns and pads it with spaces to fill them. It
+// stands in for fmt's %-*s, which counts runes rather than the columns wide
+// characters take.
+func fit(s string, width int) string {
+	s = truncate(s, width)
+	return s + strings.Repeat(" ", max(width-ansi.StringWidth(s), 0))
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"go-tournament/keymap"
+	"go-tournament/storage"
+	"go-tournament/tournament"
+)
+
+func TestLibraryKeepsOpenTournament(t *testing.T) {
+	db, err := storage.Open(filepath.Join(t.TempDir(), "tournaments.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var ids []int64
+	for _, name := range []string{"Open", "Closed"} {
+		bracket, err := tournament.NewBracketWithPlayers([]tournament.Player{{Name: "A"}, {Name: "B"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved := tournament.Tournament{Name: name, Bracket: bracket}
+		if err := db.Save(&saved); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, saved.ID)
+	}
+	open, err := db.Load(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := tournament.NewStore()
+	store.Update(func(tx *tournament.Txn) error {
+		tx.Create(open)
+		return nil
+	})
+
+	m := newLibraryModel(db, store, keymap.Default())
+	del := func(id int64) {
+		m.selectID(id)
+		for _, k := range []string{"d", "y"} {
+			m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+		}
+	}
+
+	del(ids[0])
+	if _, err := db.Load(ids[0]); err != nil {
+		t.Errorf("open tournament was deleted: %v", err)
+	}
+	del(ids[1])
+	if _, err := db.Load(ids[1]); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("closed tournament: got %v, want %v", err, storage.ErrNotFound)
+	}
+}
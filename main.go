@@ -1,39 +1,127 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"go-tournament/i18n"
+	"go-tournament/keymap"
+	"go-tournament/storage"
 	"go-tournament/tournament"
+	"go-tournament/web"
+)
+
+const (
+	// defaultDBPath is where tournaments are saved unless TOURNAMENT_DB says otherwise.
+	defaultDBPath = "tournaments.db"
+
+	// defaultExportDir is where schedules and calendars are written unless
+	// the config says otherwise.
+	defaultExportDir = "exports"
+
+	// calendarExportDir is where iCalendar feeds are written in the export
+	// directory once published.
+	calendarExportDir = "calendars"
 )
 
 type model struct {
-	currentScreen       Screen
-	menuModel           menuModel
-	singleElimination   tournament.SingleEliminationModel
-	width               int
-	height              int
+	currentScreen     Screen
+	library           libraryModel
+	registry          registryModel
+	leaderboard       leaderboardModel
+	stats             statsModel
+	menuModel         menuModel
+	singleElimination tournament.SingleEliminationModel
+	presentation      tournament.PresentationModel
+	store             *tournament.Store
+	calendars         *tournament.CalendarFeeds // Calendar feeds of the store's tournament
+	exportDir         string                    // Where schedules are written
+	reports           *tournament.ReportQueue   // Remote result reports (nil unless serving)
+	tokens            *tournament.PlayerTokens
+	keys              keymap.Keymap
+	showHelp          bool // The help overlay covers the current screen
+
+	display display
+	width   int
+	height  int
+}
+
+// display holds the config settings for how screens are drawn.
+type display struct {
+	presentationInterval time.Duration         // How long each presentation slide is shown
+	names                tournament.NameLayout // How wide the bracket draws player names
+}
+
+func newModel(store *tournament.Store, calendars *tournament.CalendarFeeds, exportDir string, db *storage.DB, keys keymap.Keymap, spectatorURLs []string, reports *tournament.ReportQueue, tokens *tournament.PlayerTokens, display display) model {
+	menu := newMenuModel(keys)
+	menu.spectatorURLs = spectatorURLs
+
+	m := model{
+		currentScreen: ScreenLibrary,
+		library:       newLibraryModel(db, store, keys),
+		registry:      newRegistryModel(db, keys),
+		leaderboard:   newLeaderboardModel(db, keys),
+		stats:         newStatsModel(db, store, keys),
+		menuModel:     menu,
+		store:         store,
+		calendars:     calendars,
+		exportDir:     exportDir,
+		reports:       reports,
+		tokens:        tokens,
+		keys:          keys,
+		display:       display,
+	}
+	m.singleElimination = m.newSingleElimination()
+	return m
 }
 
-func newModel() model {
-	return model{
-		currentScreen:     ScreenMenu,
-		menuModel:         newMenuModel(),
-		singleElimination: tournament.NewSingleEliminationModel(),
+// newSingleElimination creates the tournament screen for whatever the store holds.
+func (m model) newSingleElimination() tournament.SingleEliminationModel {
+	singleElimination := tournament.NewSingleEliminationModel().
+		WithKeymap(m.keys).
+		WithStore(m.store).
+		WithCalendars(m.calendars).
+		WithExportDir(m.exportDir).
+		WithDirectory(m.library.db).
+		WithRatings(m.library.db).
+		WithNameLayout(m.display.names)
+	if m.reports != nil {
+		singleElimination = singleElimination.WithReporting(m.reports, m.tokens)
 	}
+	return singleElimination
 }
 
 func (m model) Init() tea.Cmd {
 	return nil
 }
 
-// switchScreen changes the current screen and injects window size to the new screen model
-func (m *model) switchScreen(target Screen) {
+// switchScreen changes the current screen and injects window size to the new screen model.
+// It returns any command the new screen needs to fill itself in.
+func (m *model) switchScreen(target Screen) tea.Cmd {
 	m.currentScreen = target
 	// Pass current window size to new screen model to ensure proper initial rendering
 	sizeMsg := tea.WindowSizeMsg{Width: m.width, Height: m.height}
 	switch target {
+	case ScreenLibrary:
+		m.library.reload()
+		m.library, _ = m.library.Update(sizeMsg)
+	case ScreenRegistry:
+		m.registry.reload()
+		m.registry, _ = m.registry.Update(sizeMsg)
+	case ScreenLeaderboard:
+		m.leaderboard.reload()
+		m.leaderboard, _ = m.leaderboard.Update(sizeMsg)
+	case ScreenStats:
+		m.stats, _ = m.stats.Update(sizeMsg)
+		return m.stats.reload()
 	case ScreenMenu:
 		m.menuModel, _ = m.menuModel.Update(sizeMsg)
 	case ScreenSingleElimination:
@@ -43,34 +131,149 @@ func (m *model) switchScreen(target Screen) {
 		} else {
 			panic("type assertion failed: expected tournament.SingleEliminationModel")
 		}
+	case ScreenPresentation:
+		updated, _ := m.presentation.Update(sizeMsg)
+		if p, ok := updated.(tournament.PresentationModel); ok {
+			m.presentation = p
+		} else {
+			panic("type assertion failed: expected tournament.PresentationModel")
+		}
+	}
+	return nil
+}
+
+// startPresentation puts the current tournament on the big screen and starts
+// its slide timer.
+func (m *model) startPresentation() tea.Cmd {
+	m.presentation = tournament.NewPresentationModel(m.store).
+		WithKeymap(m.keys).
+		WithInterval(m.display.presentationInterval)
+	return tea.Batch(m.switchScreen(ScreenPresentation), m.presentation.Init())
+}
+
+// screenCapturesEsc reports whether the current screen handles Esc on its own.
+func (m model) screenCapturesEsc() bool {
+	switch m.currentScreen {
+	case ScreenSingleElimination:
+		return m.singleElimination.CapturesEsc()
+	case ScreenRegistry:
+		return m.registry.editing
+	default:
+		return false
+	}
+}
+
+// screenCapturesInput reports whether the current screen is taking typed
+// text, so letter shortcuts such as q are passed through to it.
+func (m model) screenCapturesInput() bool {
+	switch m.currentScreen {
+	case ScreenSingleElimination:
+		return m.singleElimination.CapturesInput()
+	case ScreenRegistry:
+		return m.registry.editing
+	default:
+		return false
 	}
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c", "q":
+		switch {
+		case key.Matches(msg, m.keys.Global.ForceQuit):
+			return m, tea.Quit
+		case m.showHelp:
+			// The overlay takes every key until it is closed
+			switch {
+			case key.Matches(msg, m.keys.Global.Quit):
+				return m, tea.Quit
+			case key.Matches(msg, m.keys.Global.Help, m.keys.Global.Back):
+				m.showHelp = false
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.Global.Quit) && !m.screenCapturesInput():
 			return m, tea.Quit
-		case "esc":
-			// Go back to menu from any screen
-			if m.currentScreen != ScreenMenu {
-				m.switchScreen(ScreenMenu)
-				return m, nil
+		case key.Matches(msg, m.keys.Global.Help) && !m.screenCapturesInput():
+			m.showHelp = true
+			return m, nil
+		case key.Matches(msg, m.keys.Bracket.Present) &&
+			m.currentScreen == ScreenSingleElimination && m.singleElimination.ShowsBracket():
+			return m, m.startPresentation()
+		case key.Matches(msg, m.keys.Global.Back):
+			// Go back from any screen, unless the screen steps back itself:
+			// the menu, registry, ratings and stats return to the tournament list, the
+			// presentation to the bracket, the rest to the menu
+			switch {
+			case m.currentScreen == ScreenLibrary || m.screenCapturesEsc():
+			case m.currentScreen == ScreenPresentation:
+				return m, m.switchScreen(ScreenSingleElimination)
+			case m.currentScreen == ScreenMenu || m.currentScreen == ScreenRegistry ||
+				m.currentScreen == ScreenLeaderboard || m.currentScreen == ScreenStats:
+				return m, m.switchScreen(ScreenLibrary)
+			default:
+				return m, m.switchScreen(ScreenMenu)
 			}
 		}
+	case tea.MouseMsg:
+		if m.showHelp {
+			return m, nil
+		}
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
 	case screenChangeMsg:
 		// Handle screen changes
-		m.switchScreen(msg.screen)
+		return m, m.switchScreen(msg.screen)
+	case openTournamentMsg:
+		t, err := m.library.db.Load(msg.id)
+		if err != nil {
+			m.library.statusMsg = err.Error()
+			m.library.reload()
+			return m, nil
+		}
+		if err := m.store.Update(func(tx *tournament.Txn) error {
+			tx.Create(t)
+			return nil
+		}); err != nil {
+			m.library.statusMsg = err.Error()
+			return m, nil
+		}
+		m.singleElimination = m.newSingleElimination()
+		return m, m.switchScreen(ScreenSingleElimination)
+	case statsLoadedMsg:
+		// Fill in the statistics even if the screen was left while they loaded
+		var cmd tea.Cmd
+		m.stats, cmd = m.stats.Update(msg)
+		return m, cmd
+	case saveFailedMsg:
+		m.library.statusMsg = i18n.T("Could not save tournament: %v", msg.err)
+		return m, nil
+	case serveFailedMsg:
+		m.library.statusMsg = i18n.T("Spectator server stopped: %v", msg.err)
 		return m, nil
+	case tournament.ChangeMsg, tournament.ExportFailedMsg:
+		// The shared tournament changed, or its feeds could not follow it; let
+		// the screen know even when on another screen
+		updated, cmd := m.singleElimination.Update(msg)
+		if se, ok := updated.(tournament.SingleEliminationModel); ok {
+			m.singleElimination = se
+		} else {
+			panic("type assertion failed: expected tournament.SingleEliminationModel")
+		}
+		return m, cmd
 	}
 
 	// Delegate to the appropriate screen model
 	var cmd tea.Cmd
 	switch m.currentScreen {
+	case ScreenLibrary:
+		m.library, cmd = m.library.Update(msg)
+	case ScreenRegistry:
+		m.registry, cmd = m.registry.Update(msg)
+	case ScreenLeaderboard:
+		m.leaderboard, cmd = m.leaderboard.Update(msg)
+	case ScreenStats:
+		m.stats, cmd = m.stats.Update(msg)
 	case ScreenMenu:
 		m.menuModel, cmd = m.menuModel.Update(msg)
 	case ScreenSingleElimination:
@@ -81,29 +284,183 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else {
 			panic("type assertion failed: expected tournament.SingleEliminationModel")
 		}
+	case ScreenPresentation:
+		updated, c := m.presentation.Update(msg)
+		if p, ok := updated.(tournament.PresentationModel); ok {
+			m.presentation = p
+			cmd = c
+		} else {
+			panic("type assertion failed: expected tournament.PresentationModel")
+		}
 	}
 
 	return m, cmd
 }
 
 func (m model) View() string {
+	if m.showHelp {
+		return m.renderHelp()
+	}
+
 	// Delegate to the appropriate screen view
 	switch m.currentScreen {
+	case ScreenLibrary:
+		return m.library.View()
+	case ScreenRegistry:
+		return m.registry.View()
+	case ScreenLeaderboard:
+		return m.leaderboard.View()
+	case ScreenStats:
+		return m.stats.View()
 	case ScreenMenu:
 		return m.menuModel.View()
 	case ScreenSingleElimination:
 		return m.singleElimination.View()
+	case ScreenPresentation:
+		return m.presentation.View()
 	default:
 		return "Unknown screen"
 	}
 }
 
+// saveFailedMsg reports a tournament that could not be saved to the database.
+type saveFailedMsg struct {
+	err error
+}
+
+// serveFailedMsg reports a spectator web server that stopped serving.
+type serveFailedMsg struct {
+	err error
+}
+
+// dbPath returns the tournament database to use.
+func dbPath() string {
+	if path := os.Getenv("TOURNAMENT_DB"); path != "" {
+		return path
+	}
+	return defaultDBPath
+}
+
 func main() {
-	m := newModel()
+	lang := flag.String("lang", "", "language of the interface ("+strings.Join(i18n.Tags(), ", ")+"); defaults to LANG")
+	flag.Parse()
+	args := flag.Args()
 
-	p := tea.NewProgram(m, tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
+	language := i18n.FromEnv()
+	if *lang != "" {
+		var err error
+		if language, err = i18n.Lookup(*lang); err != nil {
+			fmt.Printf("Error: %v (languages are %s)\n", err, strings.Join(i18n.Tags(), ", "))
+			os.Exit(1)
+		}
+	}
+	// Before the key bindings, whose help is translated as they are made
+	i18n.Set(language)
+
+	cfg, err := loadConfig(configPath())
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	keys, err := cfg.keymap()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	display, err := cfg.display()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	exportDir, err := cfg.exportDir()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	colors, err := cfg.theme()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	applyTheme(colors)
+	if os.Getenv("NO_COLOR") != "" {
+		// The no-colour theme sets no colours, but still marks the selection
+		// in reverse video, which the colourless profile would strip too
+		lipgloss.SetColorProfile(termenv.ANSI)
+	}
+
+	db, err := storage.Open(dbPath())
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	store := tournament.NewStore()
+	var spectatorURLs []string
+	var reports *tournament.ReportQueue
+	var tokens *tournament.PlayerTokens
+	var server *web.Server
+
+	// "ssh" serves the TUI to several organizers sharing one tournament
+	if len(args) > 0 && args[0] == "ssh" {
+		sshFlags := flag.NewFlagSet("ssh", flag.ExitOnError)
+		addr := sshFlags.String("addr", ":23234", "address for the SSH server")
+		hostKey := sshFlags.String("host-key", ".ssh/tournament_ed25519", "host key path, created if missing")
+		authorizedKeys := sshFlags.String("authorized-keys", "", "authorized_keys file of the organizers allowed to connect (required)")
+		sshFlags.Parse(args[1:])
+
+		// Each session reduces colours to what its own terminal shows, so
+		// draw in full colour whatever the server's terminal supports
+		lipgloss.SetColorProfile(termenv.TrueColor)
+
+		if err := runSSH(db, keys, display, exportDir, *addr, *hostKey, *authorizedKeys); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// "serve" runs the spectator web server alongside the TUI
+	if len(args) > 0 && args[0] == "serve" {
+		serveFlags := flag.NewFlagSet("serve", flag.ExitOnError)
+		addr := serveFlags.String("addr", ":8080", "address for the spectator web server")
+		serveFlags.Parse(args[1:])
+
+		server = web.NewServer()
+		server.Follow(store)
+		reports = tournament.NewReportQueue()
+		tokens = tournament.NewPlayerTokens()
+		tournament.FollowReporting(store, reports, tokens)
+		server.EnableReporting(reports, tokens)
+
+		ln, err := server.Listen(*addr)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		spectatorURLs = web.LANURLs(ln.Addr())
+	}
+
+	calendars := tournament.NewCalendarFeeds(store, filepath.Join(exportDir, calendarExportDir))
+	m := newModel(store, calendars, exportDir, db, keys, spectatorURLs, reports, tokens, display)
+
+	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
+	stopRecording := db.Record(store, func(err error) { go p.Send(saveFailedMsg{err: err}) })
+	calendars.OnError(func(err error) { go p.Send(tournament.ExportFailedMsg{Err: err}) })
+	if server != nil {
+		server.OnError(func(err error) { go p.Send(serveFailedMsg{err: err}) })
+	}
+	if reports != nil {
+		// Wake the TUI when players report results over HTTP
+		reports.OnChange(func() { go p.Send(tournament.ReportsChangedMsg{}) })
+	}
+	_, err = p.Run()
+	stopRecording() // Finish the last save before the database closes
+	calendars.Close()
+	if err != nil {
 		fmt.Printf("Error: %v", err)
+		db.Close()
 		os.Exit(1)
 	}
 }
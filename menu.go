@@ -2,9 +2,15 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"go-tournament/i18n"
+	"go-tournament/keymap"
+	"go-tournament/theme"
+	"go-tournament/tournament"
 )
 
 type tournamentType struct {
@@ -15,60 +21,80 @@ type tournamentType struct {
 }
 
 type menuModel struct {
-	tournaments []tournamentType
-	selected    int
-	width       int
-	height      int
+	tournaments   []tournamentType
+	selected      int
+	spectatorURLs []string // Where spectators can follow along (empty unless serving)
+	keys          keymap.Keymap
+	width         int
+	height        int
 }
 
 var (
+	cardStyle         lipgloss.Style
+	selectedCardStyle lipgloss.Style
+	titleStyle        lipgloss.Style
+	headerStyle       lipgloss.Style
+	helpStyle         lipgloss.Style
+	spectatorStyle    lipgloss.Style
+)
+
+// applyMenuTheme styles the menu, and the titles, headers and help lines
+// every screen shares, in the theme's colours.
+func applyMenuTheme(t theme.Theme) {
 	cardStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#874BFD")).
-			Padding(1, 2).
-			Width(25).
-			Height(8)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Border.Terminal()).
+		Padding(1, 2).
+		Width(25).
+		Height(8)
 
-	selectedCardStyle = cardStyle.Copy().
-				BorderForeground(lipgloss.Color("#FF69B4")).
-				Background(lipgloss.Color("#1A1A2E"))
+	selectedCardStyle = cardStyle.
+		BorderForeground(t.Selected.Terminal()).
+		Background(t.SelectedBg.Terminal()).
+		Reverse(t.Reverse)
 
 	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#FAFAFA")).
-			Align(lipgloss.Center).
-			MarginBottom(1)
+		Bold(true).
+		Foreground(t.Text.Terminal()).
+		Align(lipgloss.Center).
+		MarginBottom(1)
 
 	headerStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#FF6B6B")).
-			Align(lipgloss.Center).
-			MarginBottom(2)
+		Bold(true).
+		Foreground(t.Header.Terminal()).
+		Align(lipgloss.Center).
+		MarginBottom(2)
 
 	helpStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#626262")).
-			Align(lipgloss.Center).
-			MarginTop(2)
-)
+		Foreground(t.Muted.Terminal()).
+		Align(lipgloss.Center).
+		MarginTop(2)
+
+	spectatorStyle = lipgloss.NewStyle().
+		Foreground(t.Info.Terminal()).
+		Align(lipgloss.Center).
+		MarginTop(1)
+}
 
-func newMenuModel() menuModel {
+func newMenuModel(keys keymap.Keymap) menuModel {
 	return menuModel{
+		keys: keys,
 		tournaments: []tournamentType{
 			{
-				name:        "Single Elimination",
-				description: "Classic bracket style\nwhere losers are\neliminated instantly",
+				name:        i18n.T("Single Elimination"),
+				description: i18n.T("Classic bracket style\nwhere losers are\neliminated instantly"),
 				icon:        "🥊",
 				screen:      ScreenSingleElimination,
 			},
 			{
-				name:        "Double Elimination",
-				description: "Players get a second\nchance in the\nloser's bracket",
+				name:        i18n.T("Double Elimination"),
+				description: i18n.T("Players get a second\nchance in the\nloser's bracket"),
 				icon:        "🔄",
 				screen:      ScreenDoubleElimination,
 			},
 			{
-				name:        "Round Robin",
-				description: "Everyone plays\neveryone else\nat least once",
+				name:        i18n.T("Round Robin"),
+				description: i18n.T("Everyone plays\neveryone else\nat least once"),
 				icon:        "🔁",
 				screen:      ScreenRoundRobin,
 			},
@@ -88,20 +114,24 @@ type screenChangeMsg struct {
 func (m menuModel) Update(msg tea.Msg) (menuModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "left", "h":
+		switch {
+		case key.Matches(msg, m.keys.Menu.Left):
 			if m.selected > 0 {
 				m.selected--
 			}
-		case "right", "l":
+		case key.Matches(msg, m.keys.Menu.Right):
 			if m.selected < len(m.tournaments)-1 {
 				m.selected++
 			}
-		case "enter":
-			// Send message to switch screen
-			selectedScreen := m.tournaments[m.selected].screen
-			return m, func() tea.Msg {
-				return screenChangeMsg{screen: selectedScreen}
+		case key.Matches(msg, m.keys.Menu.Select):
+			return m, m.open()
+		}
+	case tea.MouseMsg:
+		// Clicking a card picks it, as if selected and Enter pressed
+		if msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft {
+			if card, ok := m.cardAt(msg.X, msg.Y); ok {
+				m.selected = card
+				return m, m.open()
 			}
 		}
 	case tea.WindowSizeMsg:
@@ -111,9 +141,42 @@ func (m menuModel) Update(msg tea.Msg) (menuModel, tea.Cmd) {
 	return m, nil
 }
 
+// open returns a command switching to the selected tournament type's screen.
+func (m menuModel) open() tea.Cmd {
+	selectedScreen := m.tournaments[m.selected].screen
+	return func() tea.Msg {
+		return screenChangeMsg{screen: selectedScreen}
+	}
+}
+
+// cardAt returns the card drawn at a screen cell, if any.
+func (m menuModel) cardAt(x, y int) (int, bool) {
+	sections, cards := m.sections()
+	left, top := tournament.PlacedAt(m.width, m.height, sections, 1)
+	for i, card := range cards {
+		width, height := lipgloss.Width(card), lipgloss.Height(card)
+		if x >= left && x < left+width && y >= top && y < top+height {
+			return i, true
+		}
+		left += width
+	}
+	return 0, false
+}
+
 func (m menuModel) View() string {
-	var cards []string
+	sections, _ := m.sections()
+	content := lipgloss.JoinVertical(lipgloss.Center, sections...)
 
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		content,
+	)
+}
+
+// sections returns the parts of the menu from top to bottom, the cards row
+// second, along with the cards themselves.
+func (m menuModel) sections() (sections, cards []string) {
 	for i, tournament := range m.tournaments {
 		var style lipgloss.Style
 		if i == m.selected {
@@ -133,19 +196,13 @@ func (m menuModel) View() string {
 
 	cardsRow := lipgloss.JoinHorizontal(lipgloss.Center, cards...)
 
-	header := headerStyle.Render("🏆 Tournament Manager 🏆")
-	help := helpStyle.Render("← → or h l to navigate • Enter to select • q to quit")
-
-	content := lipgloss.JoinVertical(
-		lipgloss.Center,
-		header,
-		cardsRow,
-		help,
-	)
+	header := headerStyle.Render(i18n.T("🏆 Tournament Manager 🏆"))
+	help := helpStyle.Render(keymap.ShortHelp(m.keys.Menu.Left, m.keys.Menu.Right, m.keys.Menu.Select) +
+		i18n.T(" • click a card to select • ") + keymap.ShortHelp(m.keys.Global.Help, m.keys.Global.Quit))
 
-	return lipgloss.Place(
-		m.width, m.height,
-		lipgloss.Center, lipgloss.Center,
-		content,
-	)
+	sections = []string{header, cardsRow, help}
+	if len(m.spectatorURLs) > 0 {
+		sections = append(sections, spectatorStyle.Render(i18n.T("📡 Spectators: %s", strings.Join(m.spectatorURLs, " • "))))
+	}
+	return sections, cards
 }
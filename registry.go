@@ -0,0 +1,255 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go-tournament/i18n"
+	"go-tournament/keymap"
+	"go-tournament/storage"
+	"go-tournament/theme"
+)
+
+// registryField is one editable field of the player form.
+type registryField int
+
+const (
+	registryFieldName registryField = iota
+	registryFieldAliases
+	registryFieldClub
+	registryFieldContact
+	registryFieldCount
+)
+
+// registryFieldLabels are shown next to each form field.
+var registryFieldLabels = [registryFieldCount]string{
+	"Name",
+	"Aliases",
+	"Club",
+	"Contact",
+}
+
+// registryModel manages the player registry: players kept between tournaments
+// so setup can pick them instead of retyping their names.
+type registryModel struct {
+	db            *storage.DB
+	players       []storage.RegisteredPlayer
+	selected      int
+	editing       bool                     // The form is open
+	editPlayer    storage.RegisteredPlayer // Player being edited (ID 0 when adding)
+	fields        [registryFieldCount]string
+	focus         registryField
+	confirmDelete bool   // Waiting for y to delete the selected player
+	statusMsg     string // Feedback from the last action
+	keys          keymap.Keymap
+	width         int
+	height        int
+}
+
+var (
+	registryFormStyle  lipgloss.Style
+	registryLabelStyle lipgloss.Style
+	registryFocusStyle lipgloss.Style
+)
+
+// applyRegistryTheme styles the player form in the theme's colours.
+func applyRegistryTheme(t theme.Theme) {
+	registryFormStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Selected.Terminal()).
+		Padding(1, 2)
+
+	registryLabelStyle = lipgloss.NewStyle().
+		Foreground(t.Muted.Terminal()).
+		Width(9)
+
+	registryFocusStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Info.Terminal())
+}
+
+func newRegistryModel(db *storage.DB, keys keymap.Keymap) registryModel {
+	m := registryModel{db: db, keys: keys}
+	m.reload()
+	return m
+}
+
+// reload re-reads the registry, keeping the cursor in range.
+func (m *registryModel) reload() {
+	players, err := m.db.RegisteredPlayers()
+	if err != nil {
+		m.statusMsg = err.Error()
+		return
+	}
+	m.players = players
+	if m.selected >= len(m.players) {
+		m.selected = max(len(m.players)-1, 0)
+	}
+}
+
+// edit opens the form for a player; a zero player adds a new one.
+func (m *registryModel) edit(player storage.RegisteredPlayer) {
+	m.editing = true
+	m.editPlayer = player
+	m.focus = registryFieldName
+	m.fields = [registryFieldCount]string{
+		player.Name,
+		strings.Join(player.Aliases, ", "),
+		player.Club,
+		player.Contact,
+	}
+}
+
+func (m registryModel) Update(msg tea.Msg) (registryModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.editing {
+			return m.updateForm(msg), nil
+		}
+		if m.confirmDelete {
+			m.confirmDelete = false
+			m.statusMsg = ""
+			if key.Matches(msg, m.keys.Global.Confirm) && m.selected < len(m.players) {
+				if err := m.db.DeleteRegisteredPlayer(m.players[m.selected].ID); err != nil {
+					m.statusMsg = err.Error()
+				}
+				m.reload()
+			}
+			return m, nil
+		}
+
+		m.statusMsg = ""
+		switch {
+		case key.Matches(msg, m.keys.List.Up):
+			if m.selected > 0 {
+				m.selected--
+			}
+		case key.Matches(msg, m.keys.List.Down):
+			if m.selected < len(m.players)-1 {
+				m.selected++
+			}
+		case key.Matches(msg, m.keys.Registry.Add):
+			m.edit(storage.RegisteredPlayer{})
+		case key.Matches(msg, m.keys.Registry.Edit):
+			if m.selected < len(m.players) {
+				m.edit(m.players[m.selected])
+			}
+		case key.Matches(msg, m.keys.Registry.Delete):
+			if m.selected < len(m.players) {
+				m.confirmDelete = true
+				m.statusMsg = i18n.T("Remove %s from the registry? %s to confirm, any other key to cancel",
+					m.players[m.selected].Name, m.keys.Global.Confirm.Help().Key)
+			}
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	}
+	return m, nil
+}
+
+// updateForm edits the focused field; Enter saves and Esc discards.
+func (m registryModel) updateForm(msg tea.KeyMsg) registryModel {
+	m.statusMsg = ""
+
+	switch {
+	case key.Matches(msg, m.keys.Global.Back):
+		m.editing = false
+	case key.Matches(msg, m.keys.Registry.NextField):
+		m.focus = (m.focus + 1) % registryFieldCount
+	case key.Matches(msg, m.keys.Registry.PrevField):
+		m.focus = (m.focus + registryFieldCount - 1) % registryFieldCount
+	case msg.Type == tea.KeyBackspace:
+		if runes := []rune(m.fields[m.focus]); len(runes) > 0 {
+			m.fields[m.focus] = string(runes[:len(runes)-1])
+		}
+	case msg.Type == tea.KeyRunes, msg.Type == tea.KeySpace:
+		m.fields[m.focus] += string(msg.Runes)
+	case key.Matches(msg, m.keys.Registry.Save):
+		player := m.editPlayer
+		player.Name = m.fields[registryFieldName]
+		player.Aliases = strings.Split(m.fields[registryFieldAliases], ",")
+		player.Club = strings.TrimSpace(m.fields[registryFieldClub])
+		player.Contact = strings.TrimSpace(m.fields[registryFieldContact])
+		if err := m.db.SaveRegisteredPlayer(&player); err != nil {
+			m.statusMsg = err.Error()
+			return m
+		}
+		m.editing = false
+		m.reload()
+		for i, p := range m.players {
+			if p.ID == player.ID {
+				m.selected = i
+			}
+		}
+		m.statusMsg = i18n.T("Saved %s", player.Name)
+	}
+	return m
+}
+
+func (m registryModel) View() string {
+	header := headerStyle.Render(i18n.T("👥 Player Registry"))
+
+	var body, help string
+	if m.editing {
+		title := i18n.T("New player")
+		if m.editPlayer.ID != 0 {
+			title = i18n.T("Player #%d", m.editPlayer.ID)
+		}
+		lines := []string{titleStyle.Render(title)}
+		for field := registryField(0); field < registryFieldCount; field++ {
+			value := m.fields[field]
+			if field == m.focus {
+				value = registryFocusStyle.Render(value + "▏")
+			}
+			lines = append(lines, registryLabelStyle.Render(i18n.T(registryFieldLabels[field]))+" "+value)
+		}
+		lines = append(lines, "", libraryArchivedStyle.Render(i18n.T("Separate aliases with commas")))
+		body = registryFormStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+		help = helpStyle.Render(keymap.ShortHelp(m.keys.Registry.NextField, m.keys.Registry.PrevField,
+			m.keys.Registry.Save, m.keys.Global.Back))
+	} else {
+		var rows []string
+		if len(m.players) == 0 {
+			rows = append(rows, libraryArchivedStyle.Render(i18n.T("No registered players yet. Press %s to add one.",
+				m.keys.Registry.Add.Help().Key)))
+		}
+
+		// Scroll so the cursor stays visible
+		first := 0
+		if m.selected >= libraryVisibleRows {
+			first = m.selected - libraryVisibleRows + 1
+		}
+		last := min(first+libraryVisibleRows, len(m.players))
+		for i := first; i < last; i++ {
+			rows = append(rows, m.renderRow(m.players[i], i == m.selected))
+		}
+		body = libraryListStyle.Render(strings.Join(rows, "\n"))
+		help = helpStyle.Render(keymap.ShortHelp(m.keys.List.Up, m.keys.List.Down, m.keys.Registry.Add,
+			m.keys.Registry.Edit, m.keys.Registry.Delete, m.keys.Global.Back, m.keys.Global.Help, m.keys.Global.Quit))
+	}
+
+	sections := []string{header, body}
+	if m.statusMsg != "" {
+		sections = append(sections, libraryStatusStyle.Render(m.statusMsg))
+	}
+	sections = append(sections, help)
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, sections...),
+	)
+}
+
+// renderRow renders one registered player: name, aliases, club and contact.
+func (m registryModel) renderRow(p storage.RegisteredPlayer, selected bool) string {
+	aliases := strings.Join(p.Aliases, ", ")
+	row := fit(p.Name, 22) + " " + fit(aliases, 20) + " " + fit(p.Club, 16) + " " + truncate(p.Contact, 24)
+	if selected {
+		return librarySelectedRowStyle.Render(row)
+	}
+	return libraryRowStyle.Render(row)
+}
@@ -8,4 +8,9 @@ const (
 	ScreenSingleElimination
 	ScreenDoubleElimination
 	ScreenRoundRobin
+	ScreenLibrary
+	ScreenRegistry
+	ScreenLeaderboard
+	ScreenStats
+	ScreenPresentation
 )
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/log"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	"github.com/charmbracelet/wish/activeterm"
+	"github.com/charmbracelet/wish/bubbletea"
+	"github.com/charmbracelet/wish/logging"
+	"github.com/muesli/termenv"
+	"go-tournament/keymap"
+	"go-tournament/storage"
+	"go-tournament/tournament"
+)
+
+// errNoAuthorizedKeys is returned when SSH mode is started without a list of
+// organizers. Every session has full control of the tournament, so the
+// server never runs open to anyone who can reach it.
+var errNoAuthorizedKeys = errors.New("ssh mode needs -authorized-keys: every session can change or delete tournaments")
+
+// sshShutdownTimeout bounds how long connected organizers keep their sessions
+// after the server is asked to stop.
+const sshShutdownTimeout = 10 * time.Second
+
+// sessionHub shares one tournament store between every organizer connected
+// over SSH. Each session runs its own program and model, so cursors and screens
+// are independent; the store serializes changes and each session is told about
+// changes made in the others.
+type sessionHub struct {
+	store     *tournament.Store
+	calendars *tournament.CalendarFeeds // Published by any session, followed once for all
+	exportDir string                    // Where schedules are written, on the server
+	db        *storage.DB
+	keys      keymap.Keymap
+	display   display
+}
+
+func newSessionHub(db *storage.DB, keys keymap.Keymap, display display, exportDir string) *sessionHub {
+	store := tournament.NewStore()
+	return &sessionHub{
+		store:     store,
+		calendars: tournament.NewCalendarFeeds(store, filepath.Join(exportDir, calendarExportDir)),
+		exportDir: exportDir,
+		db:        db,
+		keys:      keys,
+		display:   display,
+	}
+}
+
+// newProgram creates the program for an SSH session, starting from the
+// tournament as the other organizers currently see it.
+func (h *sessionHub) newProgram(sess ssh.Session) *tea.Program {
+	m := newModel(h.store, h.calendars, h.exportDir, h.db, h.keys, nil, nil, nil, h.display)
+	// Frames are drawn in full colour, then reduced to what this client's
+	// terminal shows, so each organizer gets colours that suit their own
+	output := &colorprofile.Writer{Forward: sess, Profile: sessionProfile(sess)}
+	program := tea.NewProgram(m,
+		tea.WithInput(sess), tea.WithOutput(output), tea.WithAltScreen(), tea.WithMouseCellMotion())
+
+	unsubscribe := h.store.Subscribe(func(event tournament.Event) {
+		// Send blocks until the program reads it, and changes are announced
+		// from whichever session made them
+		go program.Send(tournament.ChangeMsg{Event: event})
+	})
+	go func() {
+		<-sess.Context().Done()
+		unsubscribe()
+	}()
+
+	return program
+}
+
+// sessionProfile returns the colour profile of a session's terminal, as
+// detected by the session's own renderer.
+func sessionProfile(sess ssh.Session) colorprofile.Profile {
+	switch bubbletea.MakeRenderer(sess).ColorProfile() {
+	case termenv.TrueColor:
+		return colorprofile.TrueColor
+	case termenv.ANSI256:
+		return colorprofile.ANSI256
+	case termenv.ANSI:
+		return colorprofile.ANSI
+	default:
+		return colorprofile.Ascii
+	}
+}
+
+// runSSH serves the TUI over SSH until interrupted. Every connection manages
+// the same tournament, so only organizers listed in the authorized keys file
+// may connect.
+func runSSH(db *storage.DB, keys keymap.Keymap, display display, exportDir, addr, hostKeyPath, authorizedKeysPath string) error {
+	if authorizedKeysPath == "" {
+		return errNoAuthorizedKeys
+	}
+
+	hub := newSessionHub(db, keys, display, exportDir)
+	stopRecording := db.Record(hub.store, func(err error) {
+		log.Error("save tournament", "error", err)
+	})
+	defer stopRecording() // Runs after shutdown, so the last change is saved
+	hub.calendars.OnError(func(err error) {
+		log.Error("write calendars", "error", err)
+	})
+	defer hub.calendars.Close()
+	options := []ssh.Option{
+		wish.WithAddress(addr),
+		wish.WithHostKeyPath(hostKeyPath),
+		wish.WithAuthorizedKeys(authorizedKeysPath),
+		wish.WithMiddleware(
+			// Sessions keep their own terminal's colours rather than a forced minimum
+			bubbletea.MiddlewareWithProgramHandler(hub.newProgram, termenv.Ascii),
+			activeterm.Middleware(),
+			logging.Middleware(),
+		),
+	}
+
+	server, err := wish.NewServer(options...)
+	if err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Organizers can connect with: ssh -p %d <host>\n", ln.Addr().(*net.TCPAddr).Port)
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.Serve(ln)
+	}()
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, ssh.ErrServerClosed) {
+			return err
+		}
+		return nil
+	case <-done:
+	}
+
+	fmt.Println("Stopping SSH server")
+	ctx, cancel := context.WithTimeout(context.Background(), sshShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,384 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go-tournament/i18n"
+	"go-tournament/keymap"
+	"go-tournament/storage"
+	"go-tournament/theme"
+	"go-tournament/tournament"
+)
+
+// statsPlayer is a player that can be looked up on the statistics screen.
+type statsPlayer struct {
+	name  string
+	key   tournament.PlayerKey
+	stats tournament.PlayerStats
+}
+
+// statsModel shows a player's record over the current tournament and every
+// saved one, and their head-to-head against another player.
+type statsModel struct {
+	db        *storage.DB
+	store     *tournament.Store
+	saved     *savedRuns // Appearances in saved tournaments, kept between visits
+	players   []statsPlayer
+	selected  int
+	compare   int    // Player whose head-to-head against the selected one is shown (-1 for none)
+	statusMsg string // Feedback from the last action
+	keys      keymap.Keymap
+	width     int
+	height    int
+}
+
+// statsPanelStyle holds the statistics beside the player list.
+var statsPanelStyle lipgloss.Style
+
+// applyStatsTheme styles the statistics panel; it follows the list panels,
+// so it is applied after applyLibraryTheme.
+func applyStatsTheme(theme.Theme) {
+	statsPanelStyle = libraryListStyle.
+		Width(64)
+}
+
+// statsRecentMatches is how many recent matches are listed for a player.
+const statsRecentMatches = 8
+
+func newStatsModel(db *storage.DB, store *tournament.Store, keys keymap.Keymap) statsModel {
+	return statsModel{
+		db:      db,
+		store:   store,
+		saved:   &savedRuns{runs: make(map[int64]savedRun)},
+		compare: -1,
+		keys:    keys,
+	}
+}
+
+// statsLoadedMsg carries the players gathered by statsModel.reload.
+type statsLoadedMsg struct {
+	players []statsPlayer
+	err     error // Last error met; the players gathered despite it are still shown
+}
+
+// reload returns a command that gathers every player's appearances off the
+// UI goroutine, since it may have to load and replay many tournaments.
+func (m statsModel) reload() tea.Cmd {
+	db, store, saved := m.db, m.store, m.saved
+	return func() tea.Msg {
+		players, err := loadStatsPlayers(db, store, saved)
+		return statsLoadedMsg{players: players, err: err}
+	}
+}
+
+// savedRuns caches the appearances in each saved tournament, so only those
+// saved since the last visit are loaded and replayed again. It is safe for
+// concurrent use, as reloads may overlap.
+type savedRuns struct {
+	mu   sync.Mutex
+	runs map[int64]savedRun
+}
+
+// savedRun is a saved tournament's appearances as of its last save.
+type savedRun struct {
+	updatedAt   time.Time
+	appearances map[tournament.PlayerKey][]tournament.Appearance
+}
+
+// appearances returns the appearances in a saved tournament, loading it
+// again only if it was saved since it was last loaded.
+func (c *savedRuns) appearances(db *storage.DB, s storage.Summary) (map[tournament.PlayerKey][]tournament.Appearance, error) {
+	c.mu.Lock()
+	run, ok := c.runs[s.ID]
+	c.mu.Unlock()
+	if ok && run.updatedAt.Equal(s.UpdatedAt) {
+		return run.appearances, nil
+	}
+
+	t, err := db.Load(s.ID)
+	if err != nil {
+		return nil, err
+	}
+	run = savedRun{updatedAt: s.UpdatedAt, appearances: appearancesIn(t)}
+	c.mu.Lock()
+	c.runs[s.ID] = run
+	c.mu.Unlock()
+	return run.appearances, nil
+}
+
+// loadStatsPlayers gathers every player's appearances: registered players
+// across all saved tournaments, and unregistered players of the current tournament.
+func loadStatsPlayers(db *storage.DB, store *tournament.Store, saved *savedRuns) ([]statsPlayer, error) {
+	registered, err := db.RegisteredPlayers()
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := db.List(true)
+	if err != nil {
+		return nil, err
+	}
+	// Oldest first, so the most recent run wins ties for best finish
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].CreatedAt.Before(summaries[j].CreatedAt)
+	})
+
+	var players []statsPlayer
+	for _, p := range registered {
+		players = append(players, statsPlayer{name: p.Name, key: tournament.PlayerKey{RegistryID: p.ID}})
+	}
+
+	// Other sessions may be changing the current tournament, so read it under
+	// the store's lock rather than from its last save
+	appearances := make(map[tournament.PlayerKey][]tournament.Appearance)
+	var currentID int64
+	var current map[tournament.PlayerKey][]tournament.Appearance
+	store.View(func(t tournament.Tournament) {
+		currentID = t.ID
+		current = appearancesIn(t)
+		if t.Bracket == nil {
+			return
+		}
+		for _, p := range t.Bracket.Participants {
+			if p.RegistryID == 0 {
+				players = append(players, statsPlayer{name: p.Name, key: tournament.KeyOf(p, t.ID)})
+			}
+		}
+	})
+
+	for _, s := range summaries {
+		if s.ID == currentID {
+			continue
+		}
+		runs, loadErr := saved.appearances(db, s)
+		if loadErr != nil {
+			err = loadErr
+			continue
+		}
+		for key, a := range runs {
+			appearances[key] = append(appearances[key], a...)
+		}
+	}
+	for key, a := range current {
+		appearances[key] = append(appearances[key], a...)
+	}
+
+	for i := range players {
+		players[i].stats = tournament.NewPlayerStats(appearances[players[i].key])
+	}
+	return players, err
+}
+
+// setPlayers shows freshly gathered players, keeping the cursors in range.
+func (m *statsModel) setPlayers(players []statsPlayer) {
+	m.players = players
+	if m.selected >= len(m.players) {
+		m.selected = max(len(m.players)-1, 0)
+	}
+	if m.compare >= len(m.players) {
+		m.compare = -1
+	}
+}
+
+// appearancesIn returns the run of every participant in a tournament.
+func appearancesIn(t tournament.Tournament) map[tournament.PlayerKey][]tournament.Appearance {
+	appearances := make(map[tournament.PlayerKey][]tournament.Appearance)
+	if t.Bracket == nil {
+		return appearances
+	}
+	for _, p := range t.Bracket.Participants {
+		a, err := t.Bracket.Appearance(p.ID, t.ID, t.Name)
+		if err != nil {
+			continue
+		}
+		key := tournament.KeyOf(p, t.ID)
+		appearances[key] = append(appearances[key], a)
+	}
+	return appearances
+}
+
+func (m statsModel) Update(msg tea.Msg) (statsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case statsLoadedMsg:
+		// Keep the last list if nothing could be gathered at all
+		if msg.players != nil || msg.err == nil {
+			m.setPlayers(msg.players)
+		}
+		if msg.err != nil {
+			m.statusMsg = msg.err.Error()
+		}
+	case tea.KeyMsg:
+		m.statusMsg = ""
+		switch {
+		case key.Matches(msg, m.keys.List.Up):
+			if m.selected > 0 {
+				m.selected--
+			}
+		case key.Matches(msg, m.keys.List.Down):
+			if m.selected < len(m.players)-1 {
+				m.selected++
+			}
+		case key.Matches(msg, m.keys.Stats.Compare):
+			// Pin the selected player, then move to anyone else to compare
+			if m.compare == m.selected {
+				m.compare = -1
+			} else if m.selected < len(m.players) {
+				m.compare = m.selected
+			}
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	}
+	return m, nil
+}
+
+func (m statsModel) View() string {
+	header := headerStyle.Render(i18n.T("📊 Player Statistics"))
+
+	var body string
+	if len(m.players) == 0 {
+		body = libraryListStyle.Render(libraryArchivedStyle.Render(
+			i18n.T("No players yet. Register players or start a tournament.")))
+	} else {
+		// Scroll so the cursor stays visible
+		first := 0
+		if m.selected >= libraryVisibleRows {
+			first = m.selected - libraryVisibleRows + 1
+		}
+		last := min(first+libraryVisibleRows, len(m.players))
+
+		var rows []string
+		for i := first; i < last; i++ {
+			rows = append(rows, m.renderRow(i))
+		}
+
+		panel := m.renderStats(m.players[m.selected])
+		if m.compare >= 0 && m.compare != m.selected {
+			panel = m.renderHeadToHead(m.players[m.compare], m.players[m.selected])
+		}
+		body = lipgloss.JoinHorizontal(lipgloss.Top,
+			libraryListStyle.Render(strings.Join(rows, "\n")),
+			"  ",
+			statsPanelStyle.Render(panel),
+		)
+	}
+
+	compare := m.keys.Stats.Compare
+	if m.compare >= 0 {
+		compare.SetHelp(compare.Help().Key, i18n.T("on %s to stop comparing", m.players[m.compare].name))
+	}
+	help := helpStyle.Render(keymap.ShortHelp(m.keys.List.Up, m.keys.List.Down, compare,
+		m.keys.Global.Back, m.keys.Global.Help, m.keys.Global.Quit))
+
+	sections := []string{header, body}
+	if m.statusMsg != "" {
+		sections = append(sections, libraryStatusStyle.Render(m.statusMsg))
+	}
+	sections = append(sections, help)
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, sections...),
+	)
+}
+
+// renderRow renders one player with their win-loss record; the pinned player is marked.
+func (m statsModel) renderRow(i int) string {
+	p := m.players[i]
+	marker := "  "
+	if i == m.compare {
+		marker = "📌"
+	}
+	row := fmt.Sprintf("%s %s %3d-%-3d", marker, fit(p.name, 22), p.stats.Wins, p.stats.Losses)
+	switch {
+	case i == m.selected:
+		return librarySelectedRowStyle.Render(row)
+	case len(p.stats.Appearances) == 0:
+		return libraryArchivedStyle.Render(row)
+	default:
+		return libraryRowStyle.Render(row)
+	}
+}
+
+// renderStats shows a player's overall record, best finish, opponents beaten
+// and most recent matches.
+func (m statsModel) renderStats(p statsPlayer) string {
+	s := p.stats
+	lines := []string{titleStyle.Render(p.name)}
+	if len(s.Appearances) == 0 {
+		return strings.Join(append(lines, i18n.T("No matches yet")), "\n")
+	}
+
+	lines = append(lines,
+		i18n.T("Tournaments: %d", len(s.Appearances)),
+		i18n.T("Matches played: %d (%d won, %d lost)", s.Played, s.Wins, s.Losses),
+	)
+	if s.Walkovers > 0 {
+		lines = append(lines, i18n.T("Walkovers: %d", s.Walkovers))
+	}
+	lines = append(lines, i18n.T("Furthest: %s in %s", s.Best.FurthestLabel(), s.Best.Tournament))
+
+	beaten := make([]string, len(s.Beaten))
+	for i, opponent := range s.Beaten {
+		beaten[i] = opponent.Name
+	}
+	if len(beaten) > 0 {
+		lines = append(lines, i18n.T("Beaten: %s", truncate(strings.Join(beaten, ", "), 52)))
+	}
+
+	lines = append(lines, "", i18n.T("Recent matches"))
+	var recent []tournament.MatchRecord
+	for _, a := range s.Appearances {
+		recent = append(recent, a.Matches...)
+	}
+	for i := len(recent) - 1; i >= 0 && i >= len(recent)-statsRecentMatches; i-- {
+		lines = append(lines, renderMatchRecord(recent[i]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderHeadToHead shows every meeting between two players.
+func (m statsModel) renderHeadToHead(p, opponent statsPlayer) string {
+	h := p.stats.HeadToHead(opponent.key)
+	lines := []string{
+		titleStyle.Render(i18n.T("%s vs %s", p.name, opponent.name)),
+	}
+	if len(h.Matches) == 0 {
+		return strings.Join(append(lines, i18n.T("They have never met")), "\n")
+	}
+
+	lines = append(lines, i18n.T("%s leads %d-%d", p.name, h.Wins, h.Losses))
+	switch {
+	case h.Wins == h.Losses:
+		lines[len(lines)-1] = i18n.T("Level at %d-%d", h.Wins, h.Losses)
+	case h.Losses > h.Wins:
+		lines[len(lines)-1] = i18n.T("%s leads %d-%d", opponent.name, h.Losses, h.Wins)
+	}
+
+	lines = append(lines, "")
+	for i := len(h.Matches) - 1; i >= 0; i-- {
+		lines = append(lines, renderMatchRecord(h.Matches[i]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderMatchRecord renders one match from the player's side: result,
+// opponent, round and tournament.
+func renderMatchRecord(r tournament.MatchRecord) string {
+	result := i18n.T("L")
+	if r.Won {
+		result = i18n.T("W")
+	}
+	if r.Walkover {
+		result += " " + i18n.T("(w/o)")
+	}
+	return fit(result, 7) + " " + fit(r.Opponent.Name, 18) + " " + fit(r.RoundLabel(), 13) + " " + truncate(r.Tournament, 20)
+}
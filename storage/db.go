@@ -0,0 +1,165 @@
+// Package storage keeps tournaments in a local SQLite database, so a club can
+// run an event every week and look back at past ones. The driver is pure Go,
+// so no C toolchain is needed to build.
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"go-tournament/tournament"
+	_ "modernc.org/sqlite"
+)
+
+// ErrNotFound is returned when a tournament ID is not in the database.
+var ErrNotFound = errors.New("tournament not found")
+
+// migrations upgrade the schema one version at a time. The database records
+// how many have run in PRAGMA user_version; never edit or reorder entries,
+// only append new ones.
+var migrations = []string{
+	`CREATE TABLE tournaments (
+		id              INTEGER PRIMARY KEY AUTOINCREMENT,
+		name            TEXT    NOT NULL,
+		format          TEXT    NOT NULL,
+		third_place     INTEGER NOT NULL DEFAULT 0,
+		court_count     INTEGER NOT NULL DEFAULT 0,
+		schedule_courts INTEGER NOT NULL DEFAULT 1,
+		schedule_start  TEXT,
+		match_seconds   INTEGER NOT NULL DEFAULT 0,
+		rest_seconds    INTEGER NOT NULL DEFAULT 0,
+		created_at      TEXT    NOT NULL,
+		updated_at      TEXT    NOT NULL,
+		archived_at     TEXT
+	);
+
+	CREATE TABLE players (
+		tournament_id INTEGER NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+		player_id     INTEGER NOT NULL,
+		name          TEXT    NOT NULL,
+		seed          INTEGER NOT NULL,
+		PRIMARY KEY (tournament_id, player_id)
+	);
+
+	CREATE TABLE matches (
+		tournament_id INTEGER NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+		match_id      INTEGER NOT NULL,
+		round         INTEGER NOT NULL,
+		position      INTEGER NOT NULL,
+		is_third_place INTEGER NOT NULL DEFAULT 0,
+		player1_id    INTEGER,
+		player2_id    INTEGER,
+		winner_id     INTEGER,
+		status        TEXT    NOT NULL,
+		court         INTEGER,
+		started_at    TEXT,
+		completed_at  TEXT,
+		PRIMARY KEY (tournament_id, match_id)
+	);`,
+
+	`CREATE TABLE registry_players (
+		id         INTEGER PRIMARY KEY AUTOINCREMENT,
+		name       TEXT NOT NULL,
+		club       TEXT NOT NULL DEFAULT '',
+		contact    TEXT NOT NULL DEFAULT '',
+		created_at TEXT NOT NULL,
+		updated_at TEXT NOT NULL
+	);
+
+	CREATE TABLE registry_aliases (
+		player_id INTEGER NOT NULL REFERENCES registry_players(id) ON DELETE CASCADE,
+		alias     TEXT    NOT NULL,
+		PRIMARY KEY (player_id, alias)
+	);
+
+	ALTER TABLE players ADD COLUMN registry_id INTEGER REFERENCES registry_players(id) ON DELETE SET NULL;`,
+
+	`ALTER TABLE players ADD COLUMN alias TEXT NOT NULL DEFAULT '';`,
+
+	`ALTER TABLE matches ADD COLUMN held INTEGER NOT NULL DEFAULT 0;`,
+}
+
+// DB is a tournament database. It is safe for concurrent use.
+type DB struct {
+	db *sql.DB
+
+	ratingsMu  sync.Mutex
+	ratings    *tournament.Ratings // Replayed ratings, nil once a change may have moved them
+	ratingsGen int                 // Counts invalidations, so a replay racing a save is not kept
+}
+
+// Open opens the database at path, creating it if needed, and migrates the
+// schema to the latest version.
+func Open(path string) (*DB, error) {
+	dsn := "file:" + url.PathEscape(path) + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	// SQLite allows one writer; a single connection avoids "database is locked"
+	db.SetMaxOpenConns(1)
+
+	d := &DB{db: db}
+	if err := d.migrate(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrate %s: %w", path, err)
+	}
+	return d, nil
+}
+
+// Close closes the database.
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// migrate runs every migration the database has not seen yet, each in its own transaction.
+func (d *DB) migrate() error {
+	var version int
+	if err := d.db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return err
+	}
+
+	for i := version; i < len(migrations); i++ {
+		tx, err := d.db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(migrations[i]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", i+1, err)
+		}
+		// PRAGMA does not accept bound parameters
+		if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", i+1)); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatTime stores times as RFC 3339 text with nanoseconds; zero times become NULL.
+func formatTime(t time.Time) sql.NullString {
+	if t.IsZero() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: t.UTC().Format(time.RFC3339Nano), Valid: true}
+}
+
+// parseTime reads a time written by formatTime. NULL reads as the zero time.
+func parseTime(s sql.NullString) (time.Time, error) {
+	if !s.Valid {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s.String)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.Local(), nil
+}
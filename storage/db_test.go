@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+)
+
+func TestOpenMigratesToLatest(t *testing.T) {
+	db := openTestDB(t)
+
+	var version int
+	if err := db.db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		t.Fatal(err)
+	}
+	if version != len(migrations) {
+		t.Errorf("schema version %d, want %d", version, len(migrations))
+	}
+}
+
+func TestOpenUpgradesOldDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tournaments.db")
+
+	// A database left by the first release, with one saved tournament
+	old, err := sql.Open("sqlite", "file:"+path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		migrations[0],
+		"PRAGMA user_version = 1",
+		`INSERT INTO tournaments (name, format, created_at, updated_at)
+			VALUES ('Old', 'single_elimination', '2025-01-01T00:00:00Z', '2025-01-01T00:00:00Z')`,
+		`INSERT INTO players (tournament_id, player_id, name, seed) VALUES (1, 0, 'A', 1), (1, 1, 'B', 2)`,
+		`INSERT INTO matches (tournament_id, match_id, round, position, player1_id, player2_id, status)
+			VALUES (1, 0, 0, 0, 0, 1, 'Ready')`,
+	} {
+		if _, err := old.Exec(stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+	old.Close()
+
+	db, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var version int
+	if err := db.db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		t.Fatal(err)
+	}
+	if version != len(migrations) {
+		t.Errorf("schema version %d, want %d", version, len(migrations))
+	}
+	loaded, err := db.Load(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.Bracket.Participants; len(got) != 2 || got[0].Name != "A" || got[1].Name != "B" {
+		t.Errorf("players %+v, want A and B", got)
+	}
+
+	// Opening again runs nothing twice
+	db.Close()
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reopened.Close()
+}
@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"database/sql"
+	"sort"
+
+	"go-tournament/tournament"
+)
+
+// Ratings implements tournament.RatingSource by replaying every rated match in
+// the database, archived tournaments included, in the order they finished.
+// Nothing is stored in the database, so ratings and their history always match the saved
+// results. The replay is kept until the next save or deletion, so the
+// ratings returned are shared and must not be changed.
+func (d *DB) Ratings() (*tournament.Ratings, error) {
+	d.ratingsMu.Lock()
+	cached, generation := d.ratings, d.ratingsGen
+	d.ratingsMu.Unlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	results, err := d.ratedResults()
+	if err != nil {
+		return nil, err
+	}
+	ratings := tournament.NewRatings()
+	for _, result := range results {
+		ratings.Apply(result)
+	}
+
+	d.ratingsMu.Lock()
+	defer d.ratingsMu.Unlock()
+	if d.ratingsGen == generation {
+		d.ratings = ratings
+	}
+	return ratings, nil
+}
+
+// invalidateRatings drops the cached ratings after a change that may move them.
+func (d *DB) invalidateRatings() {
+	d.ratingsMu.Lock()
+	defer d.ratingsMu.Unlock()
+	d.ratings = nil
+	d.ratingsGen++
+}
+
+// ratedResults returns the results that count towards ratings, oldest first.
+func (d *DB) ratedResults() ([]tournament.RatedResult, error) {
+	rows, err := d.db.Query(`
+		SELECT m.tournament_id, t.name, m.match_id, m.completed_at, m.winner_id,
+			m.player1_id, p1.registry_id, m.player2_id, p2.registry_id
+		FROM matches m
+		JOIN tournaments t ON t.id = m.tournament_id
+		LEFT JOIN players p1 ON p1.tournament_id = m.tournament_id AND p1.player_id = m.player1_id
+		LEFT JOIN players p2 ON p2.tournament_id = m.tournament_id AND p2.player_id = m.player2_id
+		WHERE m.status = ?
+		ORDER BY m.tournament_id, m.match_id`, tournament.MatchCompleted.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []tournament.RatedResult
+	for rows.Next() {
+		var tournamentID int64
+		var name string
+		var completed sql.NullString
+		var winner, player1, player2, registry1, registry2 sql.NullInt64
+		match := tournament.Match{Status: tournament.MatchCompleted}
+		if err := rows.Scan(&tournamentID, &name, &match.ID, &completed, &winner,
+			&player1, &registry1, &player2, &registry2); err != nil {
+			return nil, err
+		}
+		if match.CompletedAt, err = parseTime(completed); err != nil {
+			return nil, err
+		}
+		match.Player1 = savedPlayer(player1, registry1)
+		match.Player2 = savedPlayer(player2, registry2)
+		for _, p := range []*tournament.Player{match.Player1, match.Player2} {
+			if p != nil && winner.Valid && int64(p.ID) == winner.Int64 {
+				match.Winner = p
+			}
+		}
+
+		if result, ok := tournament.RatedResultOf(match); ok {
+			result.TournamentID, result.Tournament = tournamentID, name
+			results = append(results, result)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Text timestamps do not sort reliably in SQL, so order them here
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].At.Before(results[j].At)
+	})
+	return results, nil
+}
+
+// savedPlayer rebuilds enough of a match slot's player to rate the match.
+func savedPlayer(id, registryID sql.NullInt64) *tournament.Player {
+	if !id.Valid {
+		return nil
+	}
+	return &tournament.Player{ID: int(id.Int64), RegistryID: registryID.Int64}
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"testing"
+
+	"go-tournament/tournament"
+)
+
+func TestRatingsFollowSaves(t *testing.T) {
+	db := openTestDB(t)
+	var players []tournament.Player
+	for _, name := range []string{"A", "B"} {
+		p, err := db.RegisterPlayer(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		players = append(players, p)
+	}
+	bracket, err := tournament.NewBracketWithPlayers(players)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := tournament.Tournament{Bracket: bracket}
+	if err := db.Save(&saved); err != nil {
+		t.Fatal(err)
+	}
+
+	before, err := db.Ratings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := db.Ratings(); again != before {
+		t.Error("ratings were replayed again with nothing saved in between")
+	}
+
+	final := bracket.FinalMatch()
+	if err := bracket.RecordResult(final.ID, final.Player1.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Save(&saved); err != nil {
+		t.Fatal(err)
+	}
+	after, err := db.Ratings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := after.Rating(players[0].RegistryID); got <= tournament.DefaultRating {
+		t.Errorf("winner rated %.2f after a save, want above %.2f", got, tournament.DefaultRating)
+	}
+}
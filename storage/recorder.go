@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"go-tournament/tournament"
+)
+
+// Record saves the store's tournament after every change until stop is
+// called. A bracket created in the TUI is inserted on its first change and
+// updated in place afterwards. Saves run on a goroutine of their own, so
+// changes never wait on the database; changes made while a save is running
+// are picked up by the next one. Save errors are passed to onError, which may
+// be nil. stop waits for a save still due to finish.
+func (d *DB) Record(store *tournament.Store, onError func(error)) (stop func()) {
+	r := &recorder{
+		db:      d,
+		store:   store,
+		onError: onError,
+		due:     make(chan struct{}, 1),
+		quit:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	unsubscribe := store.Subscribe(func(tournament.Event) {
+		select {
+		case r.due <- struct{}{}:
+		default: // A save is already due and will see this change too
+		}
+	})
+	go r.run()
+
+	return func() {
+		unsubscribe()
+		close(r.quit)
+		<-r.done
+	}
+}
+
+// recorder remembers which database row the store's current bracket was saved
+// to, since brackets created in the TUI only get an ID once first saved. Only
+// its run goroutine touches the fields below the channels.
+type recorder struct {
+	db      *DB
+	store   *tournament.Store
+	onError func(error)
+
+	due  chan struct{} // Holds a value while the store has changes not yet saved
+	quit chan struct{} // Closed by stop
+	done chan struct{} // Closed once run returns
+
+	bracket *tournament.Bracket
+	id      int64
+	name    string
+}
+
+// run saves whenever a save is due, until stop is called.
+func (r *recorder) run() {
+	defer close(r.done)
+	for {
+		select {
+		case <-r.due:
+			r.save()
+		case <-r.quit:
+			select {
+			case <-r.due:
+				r.save()
+			default:
+			}
+			return
+		}
+	}
+}
+
+func (r *recorder) save() {
+	var t tournament.Tournament
+	r.store.View(func(current tournament.Tournament) {
+		if current.Bracket == nil {
+			return
+		}
+		if current.Bracket != r.bracket {
+			r.bracket, r.id, r.name = current.Bracket, current.ID, current.Name
+		}
+		// Copy under the lock and write after, so changes never wait on the disk
+		t = current.Snapshot()
+		t.ID, t.Name = r.id, r.name
+	})
+	if t.Bracket == nil {
+		return
+	}
+	if err := r.db.Save(&t); err != nil {
+		if r.onError != nil {
+			r.onError(err)
+		}
+		return
+	}
+	if t.ID != r.id {
+		// Let the store know where a new bracket was saved, e.g. for calendar UIDs
+		bracket := r.bracket
+		r.store.Update(func(tx *tournament.Txn) error {
+			if tx.Bracket() == bracket {
+				tx.SetSaved(t.ID, t.Name)
+			}
+			return nil
+		})
+	}
+	r.id, r.name = t.ID, t.Name
+}
@@ -0,0 +1,265 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go-tournament/tournament"
+)
+
+// ErrPlayerNotRegistered is returned when a registry ID is not in the database.
+var ErrPlayerNotRegistered = errors.New("player not in registry")
+
+// RegisteredPlayer is a player kept in the registry between tournaments.
+// Saved brackets refer to registered players by ID, so renaming a player here
+// does not rewrite past results, but their history stays linked.
+type RegisteredPlayer struct {
+	ID        int64
+	Name      string   // Display name
+	Aliases   []string // Other names the player is known by, used when searching
+	Club      string
+	Contact   string // Phone number, email or similar
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Player returns the registered player as a tournament participant. The
+// shortest alias is kept for brackets too narrow for the full name.
+func (p RegisteredPlayer) Player() tournament.Player {
+	return tournament.Player{Name: p.Name, Alias: tournament.ShortestName(p.Aliases), RegistryID: p.ID}
+}
+
+// RegisteredPlayers returns the whole registry ordered by name.
+func (d *DB) RegisteredPlayers() ([]RegisteredPlayer, error) {
+	rows, err := d.db.Query(`
+		SELECT id, name, club, contact, created_at, updated_at FROM registry_players
+		ORDER BY name COLLATE NOCASE, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var players []RegisteredPlayer
+	byID := make(map[int64]int)
+	for rows.Next() {
+		p, err := scanRegisteredPlayer(rows)
+		if err != nil {
+			return nil, err
+		}
+		byID[p.ID] = len(players)
+		players = append(players, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	aliases, err := d.db.Query(`SELECT player_id, alias FROM registry_aliases ORDER BY alias COLLATE NOCASE`)
+	if err != nil {
+		return nil, err
+	}
+	defer aliases.Close()
+	for aliases.Next() {
+		var id int64
+		var alias string
+		if err := aliases.Scan(&id, &alias); err != nil {
+			return nil, err
+		}
+		if i, ok := byID[id]; ok {
+			players[i].Aliases = append(players[i].Aliases, alias)
+		}
+	}
+	return players, aliases.Err()
+}
+
+// RegisteredPlayer returns one registered player.
+func (d *DB) RegisteredPlayer(id int64) (RegisteredPlayer, error) {
+	row := d.db.QueryRow(`
+		SELECT id, name, club, contact, created_at, updated_at FROM registry_players
+		WHERE id = ?`, id)
+	p, err := scanRegisteredPlayer(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return p, fmt.Errorf("registry player %d: %w", id, ErrPlayerNotRegistered)
+	}
+	if err != nil {
+		return p, err
+	}
+
+	rows, err := d.db.Query(`
+		SELECT alias FROM registry_aliases WHERE player_id = ? ORDER BY alias COLLATE NOCASE`, id)
+	if err != nil {
+		return p, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var alias string
+		if err := rows.Scan(&alias); err != nil {
+			return p, err
+		}
+		p.Aliases = append(p.Aliases, alias)
+	}
+	return p, rows.Err()
+}
+
+// SaveRegisteredPlayer adds a player to the registry, or updates them if ID is
+// set. Names and aliases are trimmed; empty and duplicate aliases are dropped.
+// A new player's ID and timestamps are set on p.
+func (d *DB) SaveRegisteredPlayer(p *RegisteredPlayer) error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return tournament.ErrEmptyName
+	}
+	p.Aliases = cleanAliases(p.Aliases, p.Name)
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	id, created := p.ID, p.CreatedAt
+	if id == 0 {
+		created = now
+		result, err := tx.Exec(`
+			INSERT INTO registry_players (name, club, contact, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?)`,
+			p.Name, p.Club, p.Contact, formatTime(now), formatTime(now))
+		if err != nil {
+			return err
+		}
+		if id, err = result.LastInsertId(); err != nil {
+			return err
+		}
+	} else {
+		result, err := tx.Exec(`
+			UPDATE registry_players SET name = ?, club = ?, contact = ?, updated_at = ?
+			WHERE id = ?`, p.Name, p.Club, p.Contact, formatTime(now), id)
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return fmt.Errorf("registry player %d: %w", id, ErrPlayerNotRegistered)
+		}
+	}
+
+	if _, err := tx.Exec(`DELETE FROM registry_aliases WHERE player_id = ?`, id); err != nil {
+		return err
+	}
+	for _, alias := range p.Aliases {
+		if _, err := tx.Exec(`INSERT INTO registry_aliases (player_id, alias) VALUES (?, ?)`, id, alias); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	p.ID, p.CreatedAt, p.UpdatedAt = id, created, now
+	return nil
+}
+
+// DeleteRegisteredPlayer removes a player from the registry. Saved brackets
+// keep the player's name but are no longer linked to the registry.
+func (d *DB) DeleteRegisteredPlayer(id int64) error {
+	result, err := d.db.Exec(`DELETE FROM registry_players WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("registry player %d: %w", id, ErrPlayerNotRegistered)
+	}
+	// Their matches are no longer rated
+	d.invalidateRatings()
+	return nil
+}
+
+// SearchPlayers implements tournament.PlayerDirectory. Players whose name or
+// any alias contains query, ignoring case, are returned ordered by name.
+func (d *DB) SearchPlayers(query string) ([]tournament.Player, error) {
+	registered, err := d.RegisteredPlayers()
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.ToLower(strings.TrimSpace(query))
+	var players []tournament.Player
+	for _, p := range registered {
+		if query == "" || p.matches(query) {
+			players = append(players, p.Player())
+		}
+	}
+	return players, nil
+}
+
+// RegisterPlayer implements tournament.PlayerDirectory by adding a player with
+// only a name; details can be filled in later from the registry screen.
+func (d *DB) RegisterPlayer(name string) (tournament.Player, error) {
+	p := RegisteredPlayer{Name: name}
+	if err := d.SaveRegisteredPlayer(&p); err != nil {
+		return tournament.Player{}, err
+	}
+	return p.Player(), nil
+}
+
+// matches reports whether the lower-cased query occurs in the name or an alias.
+func (p RegisteredPlayer) matches(query string) bool {
+	if strings.Contains(strings.ToLower(p.Name), query) {
+		return true
+	}
+	for _, alias := range p.Aliases {
+		if strings.Contains(strings.ToLower(alias), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// rowScanner is satisfied by *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRegisteredPlayer(row rowScanner) (RegisteredPlayer, error) {
+	var p RegisteredPlayer
+	var created, updated sql.NullString
+	if err := row.Scan(&p.ID, &p.Name, &p.Club, &p.Contact, &created, &updated); err != nil {
+		return p, err
+	}
+	var err error
+	if p.CreatedAt, err = parseTime(created); err != nil {
+		return p, err
+	}
+	if p.UpdatedAt, err = parseTime(updated); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+// cleanAliases trims aliases and drops empty ones, duplicates and the display name itself.
+func cleanAliases(aliases []string, name string) []string {
+	seen := map[string]bool{strings.ToLower(name): true}
+	var cleaned []string
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		key := strings.ToLower(alias)
+		if alias == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		cleaned = append(cleaned, alias)
+	}
+	sort.Slice(cleaned, func(i, j int) bool {
+		return strings.ToLower(cleaned[i]) < strings.ToLower(cleaned[j])
+	})
+	return cleaned
+}
@@ -0,0 +1,420 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go-tournament/tournament"
+)
+
+// FormatSingleElimination identifies single elimination tournaments.
+const FormatSingleElimination = "single_elimination"
+
+// Summary describes a saved tournament for listing.
+type Summary struct {
+	ID         int64
+	Name       string
+	Format     string
+	Players    int
+	Champion   string // Empty until the final is decided
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ArchivedAt time.Time // Zero unless archived
+}
+
+// IsArchived reports whether the tournament has been archived.
+func (s Summary) IsArchived() bool {
+	return !s.ArchivedAt.IsZero()
+}
+
+// List returns saved tournaments, most recently updated first. Archived
+// tournaments are only included when includeArchived is set.
+func (d *DB) List(includeArchived bool) ([]Summary, error) {
+	rows, err := d.db.Query(`
+		SELECT t.id, t.name, t.format, t.created_at, t.updated_at, t.archived_at,
+			(SELECT COUNT(*) FROM players p WHERE p.tournament_id = t.id),
+			COALESCE((SELECT p.name FROM matches m
+				JOIN players p ON p.tournament_id = m.tournament_id AND p.player_id = m.winner_id
+				WHERE m.tournament_id = t.id AND m.is_third_place = 0
+					AND m.round = (SELECT MAX(round) FROM matches WHERE tournament_id = t.id)), '')
+		FROM tournaments t
+		WHERE ? OR t.archived_at IS NULL
+		ORDER BY t.updated_at DESC, t.id DESC`, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []Summary
+	for rows.Next() {
+		var s Summary
+		var created, updated, archived sql.NullString
+		if err := rows.Scan(&s.ID, &s.Name, &s.Format, &created, &updated, &archived,
+			&s.Players, &s.Champion); err != nil {
+			return nil, err
+		}
+		if s.CreatedAt, err = parseTime(created); err != nil {
+			return nil, err
+		}
+		if s.UpdatedAt, err = parseTime(updated); err != nil {
+			return nil, err
+		}
+		if s.ArchivedAt, err = parseTime(archived); err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, s)
+	}
+	return summaries, rows.Err()
+}
+
+// Load reopens a saved tournament with its bracket, courts and schedule settings.
+func (d *DB) Load(id int64) (tournament.Tournament, error) {
+	var t tournament.Tournament
+	var thirdPlace bool
+	var courtCount int
+	var start sql.NullString
+	var matchSeconds, restSeconds int64
+
+	err := d.db.QueryRow(`
+		SELECT name, third_place, court_count, schedule_courts, schedule_start, match_seconds, rest_seconds
+		FROM tournaments WHERE id = ?`, id).
+		Scan(&t.Name, &thirdPlace, &courtCount, &t.Schedule.Courts, &start, &matchSeconds, &restSeconds)
+	if errors.Is(err, sql.ErrNoRows) {
+		return t, fmt.Errorf("tournament %d: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return t, err
+	}
+	t.ID = id
+	t.Schedule.MatchDuration = time.Duration(matchSeconds) * time.Second
+	t.Schedule.MinRest = time.Duration(restSeconds) * time.Second
+	if t.Schedule.Start, err = parseTime(start); err != nil {
+		return t, err
+	}
+
+	players, err := d.loadPlayers(id)
+	if err != nil {
+		return t, err
+	}
+	states, courts, held, err := d.loadMatches(id)
+	if err != nil {
+		return t, err
+	}
+
+	var opts []tournament.BracketOption
+	if thirdPlace {
+		opts = append(opts, tournament.WithThirdPlaceMatch())
+	}
+	if t.Bracket, err = tournament.RestoreBracket(players, states, opts...); err != nil {
+		return t, fmt.Errorf("tournament %d: %w", id, err)
+	}
+
+	if courtCount > 0 {
+		t.Courts = tournament.NewCourtManager(t.Bracket, courtCount)
+		for matchID, court := range courts {
+			if err := t.Courts.Occupy(court, matchID); err != nil {
+				return t, fmt.Errorf("tournament %d: %w", id, err)
+			}
+		}
+		for _, matchID := range held {
+			t.Courts.Hold(matchID)
+		}
+	}
+	return t, nil
+}
+
+func (d *DB) loadPlayers(id int64) ([]tournament.Player, error) {
+	rows, err := d.db.Query(`
+		SELECT player_id, name, alias, seed, registry_id FROM players
+		WHERE tournament_id = ? ORDER BY seed`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var players []tournament.Player
+	for rows.Next() {
+		var p tournament.Player
+		var registryID sql.NullInt64
+		if err := rows.Scan(&p.ID, &p.Name, &p.Alias, &p.Seed, &registryID); err != nil {
+			return nil, err
+		}
+		p.RegistryID = registryID.Int64
+		players = append(players, p)
+	}
+	return players, rows.Err()
+}
+
+// loadMatches returns the saved match states, the court each match on court
+// is on, and the matches held off the courts.
+func (d *DB) loadMatches(id int64) ([]tournament.MatchState, map[int]int, []int, error) {
+	rows, err := d.db.Query(`
+		SELECT match_id, status, player1_id, player2_id, winner_id, court, held, started_at, completed_at FROM matches
+		WHERE tournament_id = ? ORDER BY match_id`, id)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer rows.Close()
+
+	var states []tournament.MatchState
+	var held []int
+	courts := make(map[int]int)
+	for rows.Next() {
+		var state tournament.MatchState
+		var status string
+		var player1, player2, winner, court sql.NullInt64
+		var isHeld bool
+		var started, completed sql.NullString
+		if err := rows.Scan(&state.MatchID, &status, &player1, &player2, &winner, &court, &isHeld, &started, &completed); err != nil {
+			return nil, nil, nil, err
+		}
+		if state.Status, err = tournament.ParseMatchStatus(status); err != nil {
+			return nil, nil, nil, err
+		}
+		state.Player1ID, state.Player2ID, state.WinnerID = nullablePlayer(player1), nullablePlayer(player2), nullablePlayer(winner)
+		if court.Valid {
+			courts[state.MatchID] = int(court.Int64)
+		}
+		if isHeld {
+			held = append(held, state.MatchID)
+		}
+		if state.StartedAt, err = parseTime(started); err != nil {
+			return nil, nil, nil, err
+		}
+		if state.CompletedAt, err = parseTime(completed); err != nil {
+			return nil, nil, nil, err
+		}
+		states = append(states, state)
+	}
+	return states, courts, held, rows.Err()
+}
+
+// Save writes a tournament with its players and matches. A tournament without
+// an ID is inserted, and its new ID and default name are set on t.
+func (d *DB) Save(t *tournament.Tournament) error {
+	if t.Bracket == nil {
+		return tournament.ErrNoBracket
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	courtCount := 0
+	if t.Courts != nil {
+		courtCount = len(t.Courts.Courts())
+	}
+	values := []any{
+		t.Bracket.HasThirdPlaceMatch,
+		courtCount,
+		t.Schedule.Courts,
+		formatTime(t.Schedule.Start),
+		int64(t.Schedule.MatchDuration / time.Second),
+		int64(t.Schedule.MinRest / time.Second),
+		formatTime(now),
+	}
+
+	id, name := t.ID, t.Name
+	if id == 0 {
+		if name == "" {
+			name = defaultName(now)
+		}
+		result, err := tx.Exec(`
+			INSERT INTO tournaments (third_place, court_count, schedule_courts, schedule_start,
+				match_seconds, rest_seconds, updated_at, name, format, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			append(values, name, FormatSingleElimination, formatTime(now))...)
+		if err != nil {
+			return err
+		}
+		if id, err = result.LastInsertId(); err != nil {
+			return err
+		}
+	} else {
+		result, err := tx.Exec(`
+			UPDATE tournaments SET third_place = ?, court_count = ?, schedule_courts = ?,
+				schedule_start = ?, match_seconds = ?, rest_seconds = ?, updated_at = ?
+			WHERE id = ?`, append(values, id)...)
+		if err != nil {
+			return err
+		}
+		if err := expectRow(result, id); err != nil {
+			return err
+		}
+	}
+
+	if err := d.writeBracket(tx, id, t); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	d.invalidateRatings()
+	t.ID, t.Name = id, name
+	return nil
+}
+
+// writeBracket brings the saved players and matches of a tournament in line
+// with t: rows are updated in place, new ones inserted, and those of
+// withdrawn players or matches gone in a redraw deleted.
+func (d *DB) writeBracket(tx *sql.Tx, id int64, t *tournament.Tournament) error {
+	playerIDs := make([]int, len(t.Bracket.Participants))
+	for i, p := range t.Bracket.Participants {
+		playerIDs[i] = p.ID
+		if _, err := tx.Exec(`
+			INSERT INTO players (tournament_id, player_id, name, alias, seed, registry_id) VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT (tournament_id, player_id) DO UPDATE SET
+				name = excluded.name, alias = excluded.alias, seed = excluded.seed, registry_id = excluded.registry_id`,
+			id, p.ID, p.Name, p.Alias, p.Seed, registryID(p.RegistryID)); err != nil {
+			return err
+		}
+	}
+	if err := deleteOthers(tx, "players", "player_id", id, playerIDs); err != nil {
+		return err
+	}
+
+	matchIDs := make([]int, len(t.Bracket.Matches))
+	for i, m := range t.Bracket.Matches {
+		matchIDs[i] = m.ID
+		var court sql.NullInt64
+		held := false
+		if t.Courts != nil {
+			if c, ok := t.Courts.CourtForMatch(m.ID); ok {
+				court = sql.NullInt64{Int64: int64(c.ID), Valid: true}
+			}
+			held = t.Courts.IsHeld(m.ID)
+		}
+		if _, err := tx.Exec(`
+			INSERT INTO matches (tournament_id, match_id, round, position, is_third_place,
+				player1_id, player2_id, winner_id, status, court, held, started_at, completed_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (tournament_id, match_id) DO UPDATE SET
+				round = excluded.round, position = excluded.position, is_third_place = excluded.is_third_place,
+				player1_id = excluded.player1_id, player2_id = excluded.player2_id, winner_id = excluded.winner_id,
+				status = excluded.status, court = excluded.court, held = excluded.held,
+				started_at = excluded.started_at, completed_at = excluded.completed_at`,
+			id, m.ID, m.Round, m.Position, m.IsThirdPlace,
+			playerID(m.Player1), playerID(m.Player2), playerID(m.Winner),
+			m.Status.String(), court, held, formatTime(m.StartedAt), formatTime(m.CompletedAt)); err != nil {
+			return err
+		}
+	}
+	return deleteOthers(tx, "matches", "match_id", id, matchIDs)
+}
+
+// deleteOthers deletes the rows of a tournament in table whose column is not
+// one of keep. table and column are never user input.
+func deleteOthers(tx *sql.Tx, table, column string, id int64, keep []int) error {
+	args := make([]any, 0, len(keep)+1)
+	args = append(args, id)
+	placeholders := make([]string, len(keep))
+	for i, k := range keep {
+		placeholders[i] = "?"
+		args = append(args, k)
+	}
+	query := fmt.Sprintf(`DELETE FROM %s WHERE tournament_id = ?`, table)
+	if len(keep) > 0 {
+		query += fmt.Sprintf(` AND %s NOT IN (%s)`, column, strings.Join(placeholders, ", "))
+	}
+	_, err := tx.Exec(query, args...)
+	return err
+}
+
+// Duplicate copies a tournament's players and settings into a new tournament
+// with a fresh draw and no results, e.g. for next week's event. It returns the new ID.
+func (d *DB) Duplicate(id int64) (int64, error) {
+	t, err := d.Load(id)
+	if err != nil {
+		return 0, err
+	}
+
+	var opts []tournament.BracketOption
+	if t.Bracket.HasThirdPlaceMatch {
+		opts = append(opts, tournament.WithThirdPlaceMatch())
+	}
+	bracket, err := tournament.RestoreBracket(t.Bracket.Participants, nil, opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	copied := tournament.Tournament{
+		Name:     t.Name + " (copy)",
+		Bracket:  bracket,
+		Schedule: t.Schedule,
+	}
+	if t.Courts != nil {
+		copied.Courts = tournament.NewCourtManager(bracket, len(t.Courts.Courts()))
+	}
+	if err := d.Save(&copied); err != nil {
+		return 0, err
+	}
+	return copied.ID, nil
+}
+
+// SetArchived archives or restores a tournament. Archived tournaments are
+// hidden from List unless asked for.
+func (d *DB) SetArchived(id int64, archived bool) error {
+	var archivedAt sql.NullString
+	if archived {
+		archivedAt = formatTime(time.Now())
+	}
+	result, err := d.db.Exec(`UPDATE tournaments SET archived_at = ? WHERE id = ?`, archivedAt, id)
+	if err != nil {
+		return err
+	}
+	return expectRow(result, id)
+}
+
+// Delete removes a tournament with its players and matches.
+func (d *DB) Delete(id int64) error {
+	result, err := d.db.Exec(`DELETE FROM tournaments WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	d.invalidateRatings()
+	return expectRow(result, id)
+}
+
+// expectRow turns a statement that touched no rows into ErrNotFound.
+func expectRow(result sql.Result, id int64) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("tournament %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// playerID returns a nullable player reference for a match slot.
+func playerID(p *tournament.Player) sql.NullInt64 {
+	if p == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(p.ID), Valid: true}
+}
+
+// nullablePlayer reads a nullable player reference, using -1 for NULL.
+func nullablePlayer(id sql.NullInt64) int {
+	if !id.Valid {
+		return -1
+	}
+	return int(id.Int64)
+}
+
+// registryID returns a nullable registry reference; unregistered players store NULL.
+func registryID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
+
+// defaultName names a tournament after the day and time it was created.
+func defaultName(created time.Time) string {
+	return created.Format("Mon 2 Jan 2006, 15:04")
+}
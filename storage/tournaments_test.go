@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go-tournament/tournament"
+)
+
+// openTestDB opens a fresh database in a temporary directory.
+func openTestDB(t *testing.T) *DB {
+	t.Helper()
+	db, err := Open(filepath.Join(t.TempDir(), "tournaments.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// newTestTournament returns a tournament of n players on two courts.
+func newTestTournament(t *testing.T, n int) tournament.Tournament {
+	t.Helper()
+	players := make([]tournament.Player, n)
+	for i := range players {
+		players[i] = tournament.Player{Name: string(rune('A' + i))}
+	}
+	bracket, err := tournament.NewBracketWithPlayers(players, tournament.WithThirdPlaceMatch())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tournament.Tournament{
+		Name:    "Club Night",
+		Bracket: bracket,
+		Courts:  tournament.NewCourtManager(bracket, 2),
+		Schedule: tournament.ScheduleConfig{
+			Start:         time.Date(2026, 5, 16, 19, 0, 0, 0, time.UTC),
+			MatchDuration: 20 * time.Minute,
+			Courts:        2,
+			MinRest:       5 * time.Minute,
+		},
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	db := openTestDB(t)
+	saved := newTestTournament(t, 8)
+	bracket := saved.Bracket
+
+	// One result, one match on court and one held back after being stopped
+	first := bracket.MatchesInRound(0)
+	if err := bracket.RecordResult(first[0].ID, first[0].Player2.ID); err != nil {
+		t.Fatal(err)
+	}
+	saved.Courts.Refresh()
+	onCourt := saved.Courts.Courts()[0].MatchID
+	stopped := saved.Courts.Courts()[1].MatchID
+	if err := bracket.StopMatch(stopped); err != nil {
+		t.Fatal(err)
+	}
+	saved.Courts.Hold(stopped)
+	saved.Courts.Refresh()
+
+	if err := db.Save(&saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved.ID == 0 {
+		t.Fatal("saved tournament has no ID")
+	}
+
+	loaded, err := db.Load(saved.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Name != saved.Name {
+		t.Errorf("name %q, want %q", loaded.Name, saved.Name)
+	}
+	if !loaded.Schedule.Start.Equal(saved.Schedule.Start) || loaded.Schedule.MatchDuration != saved.Schedule.MatchDuration ||
+		loaded.Schedule.MinRest != saved.Schedule.MinRest || loaded.Schedule.Courts != saved.Schedule.Courts {
+		t.Errorf("schedule %+v, want %+v", loaded.Schedule, saved.Schedule)
+	}
+	if !loaded.Bracket.HasThirdPlaceMatch {
+		t.Error("third-place match was lost")
+	}
+	for i, p := range bracket.Participants {
+		if got := loaded.Bracket.Participants[i]; got != p {
+			t.Errorf("participant %d is %+v, want %+v", i, got, p)
+		}
+	}
+	for i, match := range bracket.Matches {
+		got := loaded.Bracket.Matches[i]
+		if got.Status != match.Status || playerIDOf(got.Winner) != playerIDOf(match.Winner) ||
+			playerIDOf(got.Player1) != playerIDOf(match.Player1) || playerIDOf(got.Player2) != playerIDOf(match.Player2) {
+			t.Errorf("match %d loaded as %s %d v %d, want %s %d v %d", match.ID,
+				got.Status, playerIDOf(got.Player1), playerIDOf(got.Player2),
+				match.Status, playerIDOf(match.Player1), playerIDOf(match.Player2))
+		}
+		if !got.StartedAt.Equal(match.StartedAt) || !got.CompletedAt.Equal(match.CompletedAt) {
+			t.Errorf("match %d times %v–%v, want %v–%v", match.ID,
+				got.StartedAt, got.CompletedAt, match.StartedAt, match.CompletedAt)
+		}
+	}
+	if court, ok := loaded.Courts.CourtForMatch(onCourt); !ok || court.ID != 0 {
+		t.Errorf("match %d is not back on the first court", onCourt)
+	}
+	if !loaded.Courts.IsHeld(stopped) {
+		t.Errorf("stopped match %d is no longer held", stopped)
+	}
+}
+
+func TestSaveUpdatesInPlace(t *testing.T) {
+	db := openTestDB(t)
+	saved := newTestTournament(t, 5)
+	if err := db.Save(&saved); err != nil {
+		t.Fatal(err)
+	}
+	id := saved.ID
+
+	// A withdrawal shrinks the bracket, so rows of the old draw must go
+	if err := saved.Bracket.RemoveParticipant(4); err != nil {
+		t.Fatal(err)
+	}
+	if err := saved.Bracket.RenamePlayer(0, "Ann"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Save(&saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved.ID != id {
+		t.Fatalf("saved again as %d, want %d", saved.ID, id)
+	}
+
+	loaded, err := db.Load(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Bracket.Participants) != 4 {
+		t.Errorf("got %d players, want 4", len(loaded.Bracket.Participants))
+	}
+	if loaded.Bracket.Participants[0].Name != "Ann" {
+		t.Errorf("first player is %q, want %q", loaded.Bracket.Participants[0].Name, "Ann")
+	}
+	var matches int
+	if err := db.db.QueryRow(`SELECT COUNT(*) FROM matches WHERE tournament_id = ?`, id).Scan(&matches); err != nil {
+		t.Fatal(err)
+	}
+	if matches != len(saved.Bracket.Matches) {
+		t.Errorf("%d saved matches, want %d", matches, len(saved.Bracket.Matches))
+	}
+}
+
+func playerIDOf(p *tournament.Player) int {
+	if p == nil {
+		return -1
+	}
+	return p.ID
+}
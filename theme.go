@@ -0,0 +1,21 @@
+package main
+
+import (
+	"go-tournament/theme"
+	"go-tournament/tournament"
+)
+
+func init() {
+	applyTheme(theme.Dark)
+}
+
+// applyTheme styles every screen in the theme's colours.
+func applyTheme(t theme.Theme) {
+	applyMenuTheme(t)
+	applyLibraryTheme(t)
+	applyRegistryTheme(t)
+	applyLeaderboardTheme(t)
+	applyStatsTheme(t)
+	applyHelpTheme(t)
+	tournament.SetTheme(t)
+}
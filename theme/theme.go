@@ -0,0 +1,187 @@
+// Package theme defines the colour palettes the screens are drawn in: the
+// built-in dark, light, high-contrast and no-colour themes, and user themes
+// read from the config file.
+package theme
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ErrUnknownTheme is returned when a theme, or the base of a user theme, does not exist.
+var ErrUnknownTheme = errors.New("unknown theme")
+
+// Color is a theme colour with hand-picked fallbacks for terminals without
+// truecolor. In the config file it is either a single "#RRGGBB" string, which
+// is approximated on smaller palettes, or an object with "truecolor",
+// "ansi256" and "ansi" values.
+type Color struct {
+	TrueColor string `json:"truecolor"`
+	ANSI256   string `json:"ansi256"` // 0-255
+	ANSI      string `json:"ansi"`    // 0-15
+}
+
+// UnmarshalJSON accepts a colour as a string or an object.
+func (c *Color) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = Color{TrueColor: s}
+		return nil
+	}
+	// Fallbacks left out must not be kept from the colour this replaces
+	type plain Color // Without this method, to avoid recursing
+	*c = Color{}
+	return json.Unmarshal(data, (*plain)(c))
+}
+
+// Terminal returns the colour for lipgloss, which picks the value that suits
+// the terminal's colour profile. An empty colour leaves the terminal's own.
+func (c Color) Terminal() lipgloss.TerminalColor {
+	if c.TrueColor == "" {
+		return lipgloss.NoColor{}
+	}
+	if c.ANSI256 == "" && c.ANSI == "" {
+		return lipgloss.Color(c.TrueColor)
+	}
+	complete := lipgloss.CompleteColor{TrueColor: c.TrueColor, ANSI256: c.ANSI256, ANSI: c.ANSI}
+	if complete.ANSI256 == "" {
+		complete.ANSI256 = c.TrueColor
+	}
+	if complete.ANSI == "" {
+		complete.ANSI = c.TrueColor
+	}
+	return complete
+}
+
+// Theme assigns a colour to each role on screen.
+type Theme struct {
+	Text       Color `json:"text"`        // Body text and titles
+	Muted      Color `json:"muted"`       // Help lines, placeholders, scheduled matches
+	Faint      Color `json:"faint"`       // Byes
+	Border     Color `json:"border"`      // Panels, lists and cards
+	Header     Color `json:"header"`      // Screen and round headers
+	Selected   Color `json:"selected"`    // The item under the cursor and its border
+	SelectedBg Color `json:"selected_bg"` // Background of the item under the cursor
+	Accent     Color `json:"accent"`      // The active round tab and walkovers
+	OnAccent   Color `json:"on_accent"`   // Text on the accent colour
+	Info       Color `json:"info"`        // Counts, winners, completed matches, rating gains
+	Warning    Color `json:"warning"`     // Ready matches, status messages, the champion
+	Danger     Color `json:"danger"`      // Matches in progress, limits, rating losses
+
+	// Reverse marks the item under the cursor in reverse video, so it stands
+	// out without colour
+	Reverse bool `json:"reverse"`
+}
+
+// color is shorthand for a colour with its 256- and 16-colour fallbacks.
+func color(truecolor, ansi256, ansi string) Color {
+	return Color{TrueColor: truecolor, ANSI256: ansi256, ANSI: ansi}
+}
+
+var (
+	// Dark is the default theme, for dark terminal backgrounds.
+	Dark = Theme{
+		Text:       color("#FAFAFA", "255", "15"),
+		Muted:      color("#626262", "241", "8"),
+		Faint:      color("#3A3A3A", "237", "8"),
+		Border:     color("#874BFD", "99", "5"),
+		Header:     color("#FF6B6B", "203", "9"),
+		Selected:   color("#FF69B4", "205", "13"),
+		SelectedBg: color("#1A1A2E", "234", "0"),
+		Accent:     color("#874BFD", "99", "5"),
+		OnAccent:   color("#FAFAFA", "255", "15"),
+		Info:       color("#4ECDC4", "80", "14"),
+		Warning:    color("#FFD93D", "221", "11"),
+		Danger:     color("#FF6B6B", "203", "9"),
+	}
+
+	// Light is for light terminal backgrounds.
+	Light = Theme{
+		Text:       color("#1A1A1A", "234", "0"),
+		Muted:      color("#6B6B6B", "242", "8"),
+		Faint:      color("#B0B0B0", "249", "7"),
+		Border:     color("#6A3FD8", "98", "5"),
+		Header:     color("#C0392B", "160", "1"),
+		Selected:   color("#C2185B", "161", "5"),
+		SelectedBg: color("#F3E5F5", "255", "15"),
+		Accent:     color("#6A3FD8", "98", "5"),
+		OnAccent:   color("#FFFFFF", "231", "15"),
+		Info:       color("#00796B", "30", "6"),
+		Warning:    color("#9A6700", "136", "3"),
+		Danger:     color("#C0392B", "160", "1"),
+	}
+
+	// HighContrast uses pure, saturated colours that survive projectors and
+	// bright rooms, and marks the selection in yellow on black.
+	HighContrast = Theme{
+		Text:       color("#FFFFFF", "231", "15"),
+		Muted:      color("#D0D0D0", "252", "7"),
+		Faint:      color("#A0A0A0", "248", "7"),
+		Border:     color("#FFFFFF", "231", "15"),
+		Header:     color("#FFFFFF", "231", "15"),
+		Selected:   color("#FFFF00", "226", "11"),
+		SelectedBg: color("#000000", "16", "0"),
+		Accent:     color("#00FFFF", "51", "14"),
+		OnAccent:   color("#000000", "16", "0"),
+		Info:       color("#00FFFF", "51", "14"),
+		Warning:    color("#FFFF00", "226", "11"),
+		Danger:     color("#FF5555", "203", "9"),
+	}
+
+	// NoColor leaves every colour to the terminal and marks the selection in
+	// reverse video. It is used whenever NO_COLOR is set.
+	NoColor = Theme{Reverse: true}
+)
+
+// builtin holds the built-in themes by the name used in the config file.
+var builtin = map[string]Theme{
+	"dark":          Dark,
+	"light":         Light,
+	"high-contrast": HighContrast,
+	"no-color":      NoColor,
+}
+
+// Builtin returns the built-in theme with the given name.
+func Builtin(name string) (Theme, error) {
+	t, ok := builtin[name]
+	if !ok {
+		return Theme{}, fmt.Errorf("%q: %w", name, ErrUnknownTheme)
+	}
+	return t, nil
+}
+
+// Names returns the names of the built-in themes, sorted.
+func Names() []string {
+	names := make([]string, 0, len(builtin))
+	for name := range builtin {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Decode reads a user theme from the config file. Roles it leaves out are
+// taken from its "base" built-in theme, dark unless given.
+func Decode(data []byte) (Theme, error) {
+	var header struct {
+		Base string `json:"base"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return Theme{}, err
+	}
+	if header.Base == "" {
+		header.Base = "dark"
+	}
+	t, err := Builtin(header.Base)
+	if err != nil {
+		return Theme{}, fmt.Errorf("base: %w", err)
+	}
+	if err := json.Unmarshal(data, &t); err != nil {
+		return Theme{}, err
+	}
+	return t, nil
+}
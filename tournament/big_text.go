@@ -0,0 +1,81 @@
+package tournament
+
+import (
+	"strings"
+	"unicode"
+)
+
+// bigTextRows is the height of a line of big text.
+const bigTextRows = 5
+
+// bigGlyphs are block letters for headings read from across a room. Each
+// glyph is bigTextRows rows of equal width.
+var bigGlyphs = map[rune][bigTextRows]string{
+	'A': {" ██ ", "█  █", "████", "█  █", "█  █"},
+	'B': {"███ ", "█  █", "███ ", "█  █", "███ "},
+	'C': {" ███", "█   ", "█   ", "█   ", " ███"},
+	'D': {"███ ", "█  █", "█  █", "█  █", "███ "},
+	'E': {"████", "█   ", "███ ", "█   ", "████"},
+	'F': {"████", "█   ", "███ ", "█   ", "█   "},
+	'G': {" ███", "█   ", "█ ██", "█  █", " ███"},
+	'H': {"█  █", "█  █", "████", "█  █", "█  █"},
+	'I': {"███", " █ ", " █ ", " █ ", "███"},
+	'J': {"  ██", "   █", "   █", "█  █", " ██ "},
+	'K': {"█  █", "█ █ ", "██  ", "█ █ ", "█  █"},
+	'L': {"█   ", "█   ", "█   ", "█   ", "████"},
+	'M': {"█   █", "██ ██", "█ █ █", "█   █", "█   █"},
+	'N': {"█   █", "██  █", "█ █ █", "█  ██", "█   █"},
+	'O': {" ██ ", "█  █", "█  █", "█  █", " ██ "},
+	'P': {"███ ", "█  █", "███ ", "█   ", "█   "},
+	'Q': {" ██ ", "█  █", "█  █", "█ ██", " ███"},
+	'R': {"███ ", "█  █", "███ ", "█ █ ", "█  █"},
+	'S': {" ███", "█   ", " ██ ", "   █", "███ "},
+	'T': {"█████", "  █  ", "  █  ", "  █  ", "  █  "},
+	'U': {"█  █", "█  █", "█  █", "█  █", " ██ "},
+	'V': {"█   █", "█   █", "█   █", " █ █ ", "  █  "},
+	'W': {"█   █", "█   █", "█ █ █", "██ ██", "█   █"},
+	'X': {"█   █", " █ █ ", "  █  ", " █ █ ", "█   █"},
+	'Y': {"█   █", " █ █ ", "  █  ", "  █  ", "  █  "},
+	'Z': {"████", "   █", "  █ ", " █  ", "████"},
+	'0': {" ██ ", "█ ██", "████", "██ █", " ██ "},
+	'1': {" █ ", "██ ", " █ ", " █ ", "███"},
+	'2': {"███ ", "   █", " ██ ", "█   ", "████"},
+	'3': {"███ ", "   █", " ██ ", "   █", "███ "},
+	'4': {"█  █", "█  █", "████", "   █", "   █"},
+	'5': {"████", "█   ", "███ ", "   █", "███ "},
+	'6': {" ██ ", "█   ", "███ ", "█  █", " ██ "},
+	'7': {"████", "   █", "  █ ", " █  ", " █  "},
+	'8': {" ██ ", "█  █", " ██ ", "█  █", " ██ "},
+	'9': {" ██ ", "█  █", " ███", "   █", " ██ "},
+	' ': {"  ", "  ", "  ", "  ", "  "},
+	'-': {"    ", "    ", "████", "    ", "    "},
+	'.': {" ", " ", " ", " ", "█"},
+	':': {" ", "█", " ", "█", " "},
+}
+
+// bigText renders s in block letters, ignoring case, if every character has
+// a glyph and the result fits in width columns.
+func bigText(s string, width int) (string, bool) {
+	var rows [bigTextRows]strings.Builder
+	for i, r := range s {
+		glyph, ok := bigGlyphs[unicode.ToUpper(r)]
+		if !ok {
+			return "", false
+		}
+		for row := range rows {
+			if i > 0 {
+				rows[row].WriteString(" ")
+			}
+			rows[row].WriteString(glyph[row])
+		}
+	}
+
+	lines := make([]string, bigTextRows)
+	for row := range rows {
+		lines[row] = rows[row].String()
+		if len([]rune(lines[row])) > width {
+			return "", false
+		}
+	}
+	return strings.Join(lines, "\n"), true
+}
@@ -1,33 +1,68 @@
 package tournament
 
+import "time"
+
 // Player represents a tournament participant with seeding information.
 type Player struct {
-	ID   int    // Unique identifier for the player
-	Name string // Display name of the player
-	Seed int    // Seeding position (1 is highest seed)
+	ID         int    // Unique identifier for the player
+	Name       string // Display name of the player
+	Alias      string // Short name shown where the full name does not fit (optional)
+	Seed       int    // Seeding position (1 is highest seed)
+	RegistryID int64  // Player registry entry, shared across tournaments (0 if unregistered)
 }
 
 // Match represents a single matchup in the tournament bracket.
-// A match can be in various states: unplayed (both players TBD), bye (one player auto-advances),
-// or completed (winner is set).
+// Its lifecycle is tracked by Status: scheduled until both players are known, then ready,
+// in progress and finally completed or walkover. Byes are decided at generation.
 type Match struct {
-	ID          int     // Unique identifier for the match
-	Round       int     // Round number (0-indexed, 0 is first round)
-	Position    int     // Position within the round (0-indexed)
-	Player1     *Player // First player (nil if TBD or bye)
-	Player2     *Player // Second player (nil if TBD or bye)
-	Winner      *Player // Winner of the match (nil until match is complete)
-	NextMatchID int     // ID of match winner advances to (-1 if final)
-	IsBye       bool    // True if one player gets automatic advancement
+	ID               int         // Unique identifier for the match
+	Round            int         // Round number (0-indexed, 0 is first round)
+	Position         int         // Position within the round (0-indexed)
+	Player1          *Player     // First player (nil if TBD or bye)
+	Player2          *Player     // Second player (nil if TBD or bye)
+	Winner           *Player     // Winner of the match (nil until match is complete)
+	NextMatchID      int         // ID of match winner advances to (-1 if final)
+	LoserNextMatchID int         // ID of match loser drops to (-1 if eliminated)
+	IsBye            bool        // True if one player gets automatic advancement
+	IsThirdPlace     bool        // True for the playoff between the two semifinal losers
+	Status           MatchStatus // Lifecycle state, changed through the Bracket API
+	StartedAt        time.Time   // When play started (zero if never started)
+	CompletedAt      time.Time   // When the result was recorded (zero until decided)
 }
 
 // Bracket represents the complete tournament structure including all participants,
 // matches, and tournament state.
 type Bracket struct {
-	Participants []Player // All tournament participants with seeding
-	Matches      []Match  // All matches in the tournament
-	TotalRounds  int      // Number of rounds in the tournament
-	BracketSize  int      // Bracket size (next power of 2 from participant count)
-	CurrentRound int      // Current active round (0-indexed)
-	IsComplete   bool     // True when tournament has a winner
+	Participants       []Player // All tournament participants with seeding
+	Matches            []Match  // All matches in the tournament
+	TotalRounds        int      // Number of rounds in the tournament
+	BracketSize        int      // Bracket size (next power of 2 from participant count)
+	CurrentRound       int      // Current active round (0-indexed)
+	IsComplete         bool     // True when tournament has a winner
+	HasThirdPlaceMatch bool     // True if semifinal losers play off for third place
+
+	options bracketOptions // Generation settings, reapplied when the bracket is redrawn
+}
+
+// clone returns a deep copy of the bracket whose matches point into its own
+// participants.
+func (b *Bracket) clone() *Bracket {
+	c := *b
+	c.Participants = copyParticipants(b.Participants)
+	c.Matches = make([]Match, len(b.Matches))
+	copy(c.Matches, b.Matches)
+
+	byID := make(map[int]*Player, len(c.Participants))
+	for i := range c.Participants {
+		byID[c.Participants[i].ID] = &c.Participants[i]
+	}
+	for i := range c.Matches {
+		match := &c.Matches[i]
+		for _, slot := range []**Player{&match.Player1, &match.Player2, &match.Winner} {
+			if *slot != nil {
+				*slot = byID[(*slot).ID]
+			}
+		}
+	}
+	return &c
 }
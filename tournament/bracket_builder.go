@@ -2,16 +2,33 @@ package tournament
 
 import "fmt"
 
+// bracketOptions holds optional bracket generation settings.
+type bracketOptions struct {
+	thirdPlaceMatch bool
+}
+
+// BracketOption configures optional bracket generation behaviour.
+type BracketOption func(*bracketOptions)
+
+// WithThirdPlaceMatch adds a playoff between the two semifinal losers.
+// It has no effect on brackets with fewer than 4 participants, which have no
+// second semifinal loser.
+func WithThirdPlaceMatch() BracketOption {
+	return func(o *bracketOptions) {
+		o.thirdPlaceMatch = true
+	}
+}
+
 // NewBracket creates a complete bracket structure from participant count.
 // It orchestrates all bracket generation steps: creating players, generating matches,
 // applying seeding, distributing byes, and linking matches for winner advancement.
-func NewBracket(participantCount int) *Bracket {
-	// 1. Calculate bracket parameters
-	rounds := CalculateRounds(participantCount)
-	bracketSize := CalculateBracketSize(participantCount)
-	byes := CalculateByes(participantCount)
+func NewBracket(participantCount int, opts ...BracketOption) *Bracket {
+	var options bracketOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
 
-	// 2. Create players with default names and seeding
+	// Create players with default names and seeding
 	participants := make([]Player, participantCount)
 	for i := 0; i < participantCount; i++ {
 		participants[i] = Player{
@@ -21,20 +38,65 @@ func NewBracket(participantCount int) *Bracket {
 		}
 	}
 
-	// 3. Generate all matches
+	return buildBracket(participants, options)
+}
+
+// NewBracketWithPlayers creates a bracket for the given players, seeded in the
+// order given. Names and registry IDs are kept; IDs and seeds are assigned.
+func NewBracketWithPlayers(players []Player, opts ...BracketOption) (*Bracket, error) {
+	if len(players) < 2 {
+		return nil, ErrTooFewParticipants
+	}
+	if len(players) > MaxParticipants {
+		return nil, ErrTooManyParticipants
+	}
+
+	var options bracketOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	participants := copyParticipants(players)
+	for i := range participants {
+		participants[i].ID = i
+		participants[i].Seed = i + 1
+	}
+
+	return buildBracket(participants, options), nil
+}
+
+// buildBracket generates matches for the given participants and seats them.
+// Participants must be ordered by seed with seeds numbered 1..n.
+func buildBracket(participants []Player, options bracketOptions) *Bracket {
+	participantCount := len(participants)
+
+	// 1. Calculate bracket parameters
+	rounds := CalculateRounds(participantCount)
+	bracketSize := CalculateBracketSize(participantCount)
+	byes := CalculateByes(participantCount)
+
+	// 2. Generate all matches
 	matches := generateMatches(bracketSize, rounds)
 
-	// 4. Link matches (winner advancement)
+	// 3. Link matches (winner advancement)
 	linkMatches(matches, rounds)
 
+	// 4. Add the third-place playoff fed by semifinal losers
+	hasThirdPlace := options.thirdPlaceMatch && participantCount >= 4
+	if hasThirdPlace {
+		matches = addThirdPlaceMatch(matches, rounds)
+	}
+
 	// 5. Initialize bracket
 	bracket := &Bracket{
-		Participants: participants,
-		Matches:      matches,
-		TotalRounds:  rounds,
-		BracketSize:  bracketSize,
-		CurrentRound: 0,
-		IsComplete:   false,
+		Participants:       participants,
+		Matches:            matches,
+		TotalRounds:        rounds,
+		BracketSize:        bracketSize,
+		CurrentRound:       0,
+		IsComplete:         false,
+		HasThirdPlaceMatch: hasThirdPlace,
+		options:            options,
 	}
 
 	// 6. Assign seeding to matches
@@ -69,6 +131,7 @@ func assignPlayers(bracket *Bracket) {
 		if seedOrder[i+1] <= realParticipants {
 			match.Player2 = &bracket.Participants[seedOrder[i+1]-1]
 		}
+		refreshStatus(match)
 
 		matchIdx++
 	}
@@ -87,10 +150,11 @@ func generateMatches(bracketSize, totalRounds int) []Match {
 
 		for pos := 0; pos < matchesInRound; pos++ {
 			match := Match{
-				ID:          matchID,
-				Round:       round,
-				Position:    pos,
-				NextMatchID: -1, // Will be set by linkMatches
+				ID:               matchID,
+				Round:            round,
+				Position:         pos,
+				NextMatchID:      -1, // Will be set by linkMatches
+				LoserNextMatchID: -1,
 			}
 			matches = append(matches, match)
 			matchID++
@@ -135,6 +199,28 @@ func linkMatches(matches []Match, totalRounds int) {
 	}
 }
 
+// addThirdPlaceMatch appends a third-place match to the final round and routes
+// both semifinal losers into it. The match sits at position 1, beside the final.
+func addThirdPlaceMatch(matches []Match, totalRounds int) []Match {
+	thirdPlace := Match{
+		ID:               len(matches),
+		Round:            totalRounds - 1,
+		Position:         1,
+		NextMatchID:      -1,
+		LoserNextMatchID: -1,
+		IsThirdPlace:     true,
+	}
+
+	semifinalRound := totalRounds - 2
+	for i := range matches {
+		if matches[i].Round == semifinalRound {
+			matches[i].LoserNextMatchID = thirdPlace.ID
+		}
+	}
+
+	return append(matches, thirdPlace)
+}
+
 // generateSeedOrder creates the standard bracket seeding order recursively.
 // For a bracket size of n, returns a slice of seed numbers in the order they should appear
 // in the bracket to ensure proper competitive balance (top seeds don't meet until later rounds).
@@ -195,6 +281,7 @@ func advancePlayer(bracket *Bracket, nextMatchID int, player *Player) {
 	} else {
 		panic(fmt.Sprintf("match %d has no empty slots for player %d advancement", nextMatchID, player.ID))
 	}
+	refreshStatus(nextMatch)
 }
 
 // assignByes assigns automatic advancement (byes) to top-seeded players.
@@ -216,6 +303,7 @@ func assignByes(bracket *Bracket, byeCount int) {
 		match.Player1 = player
 		match.Player2 = nil
 		match.Winner = player
+		match.Status = MatchBye
 
 		// Advance player to next match
 		advancePlayer(bracket, match.NextMatchID, player)
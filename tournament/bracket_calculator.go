@@ -1,6 +1,10 @@
 package tournament
 
-import "math/bits"
+import (
+	"math/bits"
+
+	"go-tournament/i18n"
+)
 
 // CalculateRounds calculates the number of rounds needed for a given number of participants.
 // For example:
@@ -76,23 +80,22 @@ func CalculateMatchesPerRound(participants int) []int {
 	return matches
 }
 
-// GetRoundName returns a human-readable name for a given round number.
-// The last round is "Final", second-to-last is "Semifinals", etc.
+// GetRoundName returns a human-readable name for a given round number in
+// the current language, such as "Semifinals", or "" for early rounds the
+// language gives no name.
 func GetRoundName(roundNumber, totalRounds int) string {
 	if roundNumber < 1 || roundNumber > totalRounds {
 		return ""
 	}
+	// Each round halves the field, down to the final's two players
+	return i18n.RoundName(1 << (totalRounds - roundNumber + 1))
+}
 
-	roundsFromEnd := totalRounds - roundNumber
-
-	switch roundsFromEnd {
-	case 0:
-		return "Final"
-	case 1:
-		return "Semifinals"
-	case 2:
-		return "Quarterfinals"
-	default:
-		return ""
+// roundLabel returns the display label for a 0-indexed round, falling back to
+// "Round N" for early rounds that have no special name.
+func roundLabel(round, totalRounds int) string {
+	if name := GetRoundName(round+1, totalRounds); name != "" {
+		return name
 	}
+	return i18n.T("Round %d", round+1)
 }
@@ -0,0 +1,132 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrMatchNotFound is returned when a match ID does not belong to the bracket.
+	ErrMatchNotFound = errors.New("match not found")
+
+	// ErrMatchNotReady is returned when a result is entered before both players are known.
+	ErrMatchNotReady = errors.New("match does not have both players yet")
+
+	// ErrMatchDecided is returned when a result is entered for a match that already has a winner.
+	ErrMatchDecided = errors.New("match already has a winner")
+
+	// ErrInvalidWinner is returned when the winner is not one of the match's players.
+	ErrInvalidWinner = errors.New("winner is not playing in this match")
+)
+
+// Loser returns the player who lost a completed match.
+// Returns nil if the match is undecided or was a bye.
+func (m *Match) Loser() *Player {
+	if m.Winner == nil || m.IsBye {
+		return nil
+	}
+	if m.Winner == m.Player1 {
+		return m.Player2
+	}
+	return m.Player1
+}
+
+// MatchByID returns the match with the given ID.
+func (b *Bracket) MatchByID(matchID int) (*Match, error) {
+	if matchID < 0 || matchID >= len(b.Matches) {
+		return nil, fmt.Errorf("match %d: %w", matchID, ErrMatchNotFound)
+	}
+	return &b.Matches[matchID], nil
+}
+
+// MatchesInRound returns the matches of a round ordered by position.
+// The third-place match is not part of any round; use ThirdPlaceMatch instead.
+func (b *Bracket) MatchesInRound(round int) []*Match {
+	var matches []*Match
+	for i := range b.Matches {
+		match := &b.Matches[i]
+		if match.Round == round && !match.IsThirdPlace {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+// FinalMatch returns the championship match, or nil for an empty bracket.
+func (b *Bracket) FinalMatch() *Match {
+	matches := b.MatchesInRound(b.TotalRounds - 1)
+	if len(matches) == 0 {
+		return nil
+	}
+	return matches[0]
+}
+
+// ThirdPlaceMatch returns the third-place playoff, or nil if the bracket has none.
+func (b *Bracket) ThirdPlaceMatch() *Match {
+	for i := range b.Matches {
+		if b.Matches[i].IsThirdPlace {
+			return &b.Matches[i]
+		}
+	}
+	return nil
+}
+
+// RecordResult sets the winner of a played match and advances both players.
+// The winner moves to NextMatchID and, where the bracket routes losers onward,
+// the loser moves to LoserNextMatchID.
+func (b *Bracket) RecordResult(matchID, winnerID int) error {
+	return b.decideMatch(matchID, winnerID, MatchCompleted)
+}
+
+// decideMatch sets the winner of a ready or in-progress match, moves it to status
+// and advances both players.
+func (b *Bracket) decideMatch(matchID, winnerID int, status MatchStatus) error {
+	match, err := b.MatchByID(matchID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case match.Status.IsDecided():
+		return fmt.Errorf("match %d: %w", matchID, ErrMatchDecided)
+	case match.Status == MatchScheduled:
+		return fmt.Errorf("match %d: %w", matchID, ErrMatchNotReady)
+	}
+
+	var winner *Player
+	switch winnerID {
+	case match.Player1.ID:
+		winner = match.Player1
+	case match.Player2.ID:
+		winner = match.Player2
+	default:
+		return fmt.Errorf("match %d, player %d: %w", matchID, winnerID, ErrInvalidWinner)
+	}
+
+	match.Winner = winner
+	match.Status = status
+	match.CompletedAt = time.Now()
+	advancePlayer(b, match.NextMatchID, winner)
+	advancePlayer(b, match.LoserNextMatchID, match.Loser())
+
+	b.updateProgress()
+	return nil
+}
+
+// updateProgress recomputes CurrentRound and IsComplete from match winners.
+// The current round is the earliest round that still has an undecided match.
+func (b *Bracket) updateProgress() {
+	current := b.TotalRounds
+	for _, match := range b.Matches {
+		if match.Winner == nil && match.Round < current {
+			current = match.Round
+		}
+	}
+
+	b.IsComplete = current == b.TotalRounds
+	if b.IsComplete {
+		current = b.TotalRounds - 1
+	}
+	b.CurrentRound = current
+}
@@ -0,0 +1,181 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MaxParticipants is the most players a bracket holds.
+const MaxParticipants = 64
+
+var (
+	// ErrResultsRecorded is returned when the participant set is changed after play has started.
+	ErrResultsRecorded = errors.New("bracket already has recorded results")
+
+	// ErrPlayerNotFound is returned when a player ID does not belong to the bracket.
+	ErrPlayerNotFound = errors.New("player not found")
+
+	// ErrTooFewParticipants is returned when a change would leave fewer than two participants.
+	ErrTooFewParticipants = errors.New("a bracket needs at least 2 participants")
+
+	// ErrTooManyParticipants is returned when a change would leave more than MaxParticipants.
+	ErrTooManyParticipants = fmt.Errorf("a bracket holds at most %d participants", MaxParticipants)
+
+	// ErrEmptyName is returned when a player would be left without a name.
+	ErrEmptyName = errors.New("player name cannot be empty")
+)
+
+// HasResults reports whether any non-bye match has a winner.
+// Byes are decided during generation and do not count as results.
+func (b *Bracket) HasResults() bool {
+	for _, match := range b.Matches {
+		if !match.IsBye && match.Winner != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// AddParticipant adds a late entry as the lowest seed and redraws the bracket.
+// Existing players keep their IDs, names and seeds.
+func (b *Bracket) AddParticipant(name string) (Player, error) {
+	if b.HasResults() {
+		return Player{}, ErrResultsRecorded
+	}
+	if len(b.Participants) >= MaxParticipants {
+		return Player{}, ErrTooManyParticipants
+	}
+
+	nextID := 0
+	for _, p := range b.Participants {
+		if p.ID >= nextID {
+			nextID = p.ID + 1
+		}
+	}
+
+	player := Player{
+		ID:   nextID,
+		Name: name,
+		Seed: len(b.Participants) + 1,
+	}
+
+	participants := append(copyParticipants(b.Participants), player)
+	b.rebuild(participants)
+
+	return player, nil
+}
+
+// RemoveParticipant withdraws a player and redraws the bracket.
+// Players seeded below the removed one move up a seed; relative order is kept.
+func (b *Bracket) RemoveParticipant(playerID int) error {
+	if b.HasResults() {
+		return ErrResultsRecorded
+	}
+	if len(b.Participants) <= 2 {
+		return ErrTooFewParticipants
+	}
+
+	participants := make([]Player, 0, len(b.Participants)-1)
+	found := false
+	for _, p := range b.Participants {
+		if p.ID == playerID {
+			found = true
+			continue
+		}
+		participants = append(participants, p)
+	}
+	if !found {
+		return fmt.Errorf("remove player %d: %w", playerID, ErrPlayerNotFound)
+	}
+
+	b.rebuild(participants)
+	return nil
+}
+
+// RenamePlayer changes a player's display name. Unlike other participant
+// changes it is allowed at any time, since it does not affect the draw.
+func (b *Bracket) RenamePlayer(playerID int, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyName
+	}
+
+	for i := range b.Participants {
+		if b.Participants[i].ID == playerID {
+			// Matches point into Participants, so every match sees the new name
+			b.Participants[i].Name = name
+			return nil
+		}
+	}
+	return fmt.Errorf("rename player %d: %w", playerID, ErrPlayerNotFound)
+}
+
+// SwapSeeds manually places two players into each other's bracket slots.
+// Placement is derived from seeding, so swapped slots survive later redraws.
+func (b *Bracket) SwapSeeds(playerA, playerB int) error {
+	if b.HasResults() {
+		return ErrResultsRecorded
+	}
+
+	participants := copyParticipants(b.Participants)
+	a, bIdx := -1, -1
+	for i, p := range participants {
+		switch p.ID {
+		case playerA:
+			a = i
+		case playerB:
+			bIdx = i
+		}
+	}
+	if a == -1 {
+		return fmt.Errorf("swap player %d: %w", playerA, ErrPlayerNotFound)
+	}
+	if bIdx == -1 {
+		return fmt.Errorf("swap player %d: %w", playerB, ErrPlayerNotFound)
+	}
+
+	participants[a].Seed, participants[bIdx].Seed = participants[bIdx].Seed, participants[a].Seed
+	b.rebuild(participants)
+	return nil
+}
+
+// Redraw rebuilds the bracket for a new participant set.
+// Players are placed by their Seed values; gaps and duplicates are resolved
+// by keeping the given order among equal seeds and renumbering from 1.
+func (b *Bracket) Redraw(participants []Player) error {
+	if b.HasResults() {
+		return ErrResultsRecorded
+	}
+	if len(participants) < 2 {
+		return ErrTooFewParticipants
+	}
+	if len(participants) > MaxParticipants {
+		return ErrTooManyParticipants
+	}
+
+	b.rebuild(copyParticipants(participants))
+	return nil
+}
+
+// rebuild regenerates rounds, byes and matches for participants in place.
+// Participants are sorted by seed and renumbered so seeds are contiguous.
+func (b *Bracket) rebuild(participants []Player) {
+	sort.SliceStable(participants, func(i, j int) bool {
+		return participants[i].Seed < participants[j].Seed
+	})
+	for i := range participants {
+		participants[i].Seed = i + 1
+	}
+
+	*b = *buildBracket(participants, b.options)
+}
+
+// copyParticipants returns a copy of players that does not alias the bracket's slice.
+// Matches hold pointers into Participants, so it must never be appended to in place.
+func copyParticipants(players []Player) []Player {
+	out := make([]Player, len(players))
+	copy(out, players)
+	return out
+}
@@ -0,0 +1,136 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestRedrawRenumbersSeeds(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(b *Bracket) error
+		want   []string // Names in seed order after the change
+	}{
+		{
+			name: "late entry is the lowest seed",
+			change: func(b *Bracket) error {
+				_, err := b.AddParticipant("E")
+				return err
+			},
+			want: []string{"A", "B", "C", "D", "E"},
+		},
+		{
+			name:   "players below a withdrawal move up",
+			change: func(b *Bracket) error { return b.RemoveParticipant(1) },
+			want:   []string{"A", "C", "D"},
+		},
+		{
+			name:   "swapped seeds trade places",
+			change: func(b *Bracket) error { return b.SwapSeeds(0, 3) },
+			want:   []string{"D", "B", "C", "A"},
+		},
+		{
+			name: "gaps and ties close up in the order given",
+			change: func(b *Bracket) error {
+				return b.Redraw([]Player{
+					{ID: 0, Name: "A", Seed: 7},
+					{ID: 1, Name: "B", Seed: 3},
+					{ID: 2, Name: "C", Seed: 3},
+					{ID: 3, Name: "D", Seed: 10},
+				})
+			},
+			want: []string{"B", "C", "A", "D"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bracket, err := NewBracketWithPlayers([]Player{{Name: "A"}, {Name: "B"}, {Name: "C"}, {Name: "D"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.change(bracket); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(bracket.Participants) != len(tt.want) {
+				t.Fatalf("got %d participants, want %d", len(bracket.Participants), len(tt.want))
+			}
+			for i, p := range bracket.Participants {
+				if p.Name != tt.want[i] || p.Seed != i+1 {
+					t.Errorf("participant %d is %s seeded %d, want %s seeded %d", i, p.Name, p.Seed, tt.want[i], i+1)
+				}
+			}
+			if want := CalculateBracketSize(len(tt.want)); bracket.BracketSize != want {
+				t.Errorf("bracket size %d, want %d", bracket.BracketSize, want)
+			}
+		})
+	}
+}
+
+func TestRedrawKeepsRecordedResults(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(b *Bracket) error
+	}{
+		{"add", func(b *Bracket) error { _, err := b.AddParticipant("E"); return err }},
+		{"remove", func(b *Bracket) error { return b.RemoveParticipant(3) }},
+		{"swap", func(b *Bracket) error { return b.SwapSeeds(0, 3) }},
+		{"redraw", func(b *Bracket) error { return b.Redraw(copyParticipants(b.Participants)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bracket, err := NewBracketWithPlayers([]Player{{Name: "A"}, {Name: "B"}, {Name: "C"}, {Name: "D"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			semi := bracket.MatchesInRound(0)[0]
+			if err := bracket.RecordResult(semi.ID, semi.Player1.ID); err != nil {
+				t.Fatal(err)
+			}
+			winner := semi.Player1.ID
+
+			if err := tt.change(bracket); !errors.Is(err, ErrResultsRecorded) {
+				t.Fatalf("change after a result: got %v, want %v", err, ErrResultsRecorded)
+			}
+			if len(bracket.Participants) != 4 {
+				t.Errorf("got %d participants, want 4", len(bracket.Participants))
+			}
+			semi = bracket.MatchesInRound(0)[0]
+			if semi.Winner == nil || semi.Winner.ID != winner {
+				t.Fatalf("recorded result was lost: winner %v", semi.Winner)
+			}
+
+			// Renaming does not touch the draw, so it is still allowed
+			if err := bracket.RenamePlayer(winner, "Renamed"); err != nil {
+				t.Fatal(err)
+			}
+			if semi.Winner.Name != "Renamed" {
+				t.Errorf("winner shows as %q after renaming, want %q", semi.Winner.Name, "Renamed")
+			}
+		})
+	}
+}
+
+func TestAddParticipantRefusesFullBracket(t *testing.T) {
+	players := make([]Player, MaxParticipants)
+	for i := range players {
+		players[i] = Player{Name: fmt.Sprintf("P%d", i+1)}
+	}
+	bracket, err := NewBracketWithPlayers(players)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := bracket.AddParticipant("Late"); !errors.Is(err, ErrTooManyParticipants) {
+		t.Errorf("adding to a full bracket: got %v, want %v", err, ErrTooManyParticipants)
+	}
+	if len(bracket.Participants) != MaxParticipants {
+		t.Errorf("got %d participants, want %d", len(bracket.Participants), MaxParticipants)
+	}
+	if _, err := NewBracketWithPlayers(append(players, Player{Name: "Late"})); !errors.Is(err, ErrTooManyParticipants) {
+		t.Errorf("drawing %d players: got %v, want %v", MaxParticipants+1, err, ErrTooManyParticipants)
+	}
+}
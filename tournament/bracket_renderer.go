@@ -0,0 +1,532 @@
+package tournament
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"go-tournament/i18n"
+	"go-tournament/theme"
+)
+
+const (
+	panelNameWidth   = 14 // Names in the court panel and report queue
+	compactNameWidth = 10 // Most each side of a one-line match gets
+	minimapNameWidth = 8  // Most a winner gets on the minimap
+	roundHeaderRows  = 2  // Round title and a blank line
+	slotInfoWidth    = 6  // Space for a start time or court beside each name
+)
+
+// BracketZoom is how much detail the bracket view draws for each match.
+type BracketZoom int
+
+const (
+	ZoomFull    BracketZoom = iota // Bordered boxes with both players, start times and courts
+	ZoomCompact                    // One line per match
+	ZoomMinimap                    // Winners only, to take in a large draw at a glance
+)
+
+// String returns the zoom level's display name.
+func (z BracketZoom) String() string {
+	switch z {
+	case ZoomFull:
+		return "Full"
+	case ZoomCompact:
+		return "Compact"
+	case ZoomMinimap:
+		return "Minimap"
+	default:
+		return fmt.Sprintf("BracketZoom(%d)", int(z))
+	}
+}
+
+// Next returns the following zoom level, wrapping from the minimap back to full.
+func (z BracketZoom) Next() BracketZoom {
+	return (z + 1) % (ZoomMinimap + 1)
+}
+
+// bracketLayout is the geometry of a zoom level.
+type bracketLayout struct {
+	boxHeight      int // Rows a match takes
+	slotHeight     int // Rows between first-round matches
+	anchorRow      int // Row within a match that connector lines attach to
+	connectorWidth int
+}
+
+var bracketLayouts = map[BracketZoom]bracketLayout{
+	ZoomFull:    {boxHeight: 4, slotHeight: 5, anchorRow: 1, connectorWidth: 4}, // Border, two players, border
+	ZoomCompact: {boxHeight: 1, slotHeight: 2, anchorRow: 0, connectorWidth: 4},
+	ZoomMinimap: {boxHeight: 1, slotHeight: 1, anchorRow: 0, connectorWidth: 1}, // Too tight for connector lines
+}
+
+var (
+	bracketRoundHeaderStyle   lipgloss.Style
+	bracketMatchStyle         lipgloss.Style
+	bracketSelectedMatchStyle lipgloss.Style
+	bracketPathMatchStyle     lipgloss.Style
+	bracketRouteMatchStyle    lipgloss.Style
+	bracketTracedStyle        lipgloss.Style
+	bracketWinnerStyle        lipgloss.Style
+	bracketLoserStyle         lipgloss.Style
+	bracketPlaceholderStyle   lipgloss.Style
+	bracketConnectorStyle     lipgloss.Style
+	bracketTabStyle           lipgloss.Style
+	bracketActiveTabStyle     lipgloss.Style
+	bracketSelectedLineStyle  lipgloss.Style
+
+	// bracketStatusColors colour-codes match borders and the legend by status
+	bracketStatusColors map[MatchStatus]lipgloss.TerminalColor
+)
+
+// applyBracketTheme styles the bracket in the theme's colours.
+func applyBracketTheme(t theme.Theme) {
+	bracketRoundHeaderStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Header.Terminal()).
+		Align(lipgloss.Center)
+
+	bracketMatchStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Muted.Terminal()).
+		Padding(0, 1)
+
+	bracketStatusColors = map[MatchStatus]lipgloss.TerminalColor{
+		MatchScheduled:  t.Muted.Terminal(),
+		MatchReady:      t.Warning.Terminal(),
+		MatchInProgress: t.Danger.Terminal(),
+		MatchCompleted:  t.Info.Terminal(),
+		MatchWalkover:   t.Accent.Terminal(),
+		MatchBye:        t.Faint.Terminal(),
+	}
+
+	bracketSelectedMatchStyle = bracketMatchStyle.
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(t.Selected.Terminal())
+
+	// A traced player's matches keep their status colour in a heavier border
+	bracketPathMatchStyle = bracketMatchStyle.
+		Border(lipgloss.ThickBorder())
+
+	// Matches a traced player would reach by winning on are dashed, as not yet certain
+	bracketRouteMatchStyle = bracketMatchStyle.
+		Border(bracketRouteBorder)
+
+	bracketTracedStyle = lipgloss.NewStyle().
+		Bold(true).
+		Underline(true).
+		Foreground(t.Selected.Terminal())
+
+	bracketWinnerStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Info.Terminal())
+
+	bracketLoserStyle = lipgloss.NewStyle().
+		Foreground(t.Muted.Terminal()).
+		Strikethrough(true)
+
+	bracketPlaceholderStyle = lipgloss.NewStyle().
+		Foreground(t.Muted.Terminal()).
+		Italic(true)
+
+	bracketConnectorStyle = lipgloss.NewStyle().
+		Foreground(t.Muted.Terminal())
+
+	bracketTabStyle = lipgloss.NewStyle().
+		Foreground(t.Muted.Terminal()).
+		Padding(0, 1)
+
+	bracketActiveTabStyle = bracketTabStyle.
+		Bold(true).
+		Foreground(t.OnAccent.Terminal()).
+		Background(t.Accent.Terminal()).
+		Reverse(t.Reverse)
+
+	bracketSelectedLineStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Selected.Terminal()).
+		Background(t.SelectedBg.Terminal()).
+		Reverse(t.Reverse)
+}
+
+// bracketRouteBorder is a rounded border drawn with dashes.
+var bracketRouteBorder = lipgloss.Border{
+	Top:         "╌",
+	Bottom:      "╌",
+	Left:        "╎",
+	Right:       "╎",
+	TopLeft:     "╭",
+	TopRight:    "╮",
+	BottomLeft:  "╰",
+	BottomRight: "╯",
+}
+
+// bracketRenderer draws a bracket as round columns joined by connector lines.
+// Rounds go left to right and each match box is centred between its two feeders.
+type bracketRenderer struct {
+	bracket         *Bracket
+	selectedMatchID int         // Match drawn with the selection border (-1 for none)
+	schedule        *Schedule   // Estimated start times shown in full match boxes (nil to hide)
+	zoom            BracketZoom // Detail drawn for each match
+	names           NameLayout  // How wide names are drawn
+	path            *PlayerPath // Player whose way through the bracket is highlighted (nil for none)
+	firstRound      int         // First round column drawn
+	lastRound       int         // Last round column drawn
+}
+
+func newBracketRenderer(bracket *Bracket, selectedMatchID int) bracketRenderer {
+	r := bracketRenderer{
+		bracket:         bracket,
+		selectedMatchID: selectedMatchID,
+		names:           DefaultNameLayout,
+	}
+	if bracket != nil {
+		r.lastRound = bracket.TotalRounds - 1
+	}
+	return r
+}
+
+// focus limits drawing to count rounds starting at first. The first round
+// drawn is stacked tightly, as the first round of the bracket would be.
+func (r *bracketRenderer) focus(first, count int) {
+	r.firstRound = first
+	r.lastRound = min(first+count, r.bracket.TotalRounds) - 1
+}
+
+// layout returns the geometry of the renderer's zoom level.
+func (r bracketRenderer) layout() bracketLayout {
+	return bracketLayouts[r.zoom]
+}
+
+// Render returns the full bracket as a styled string.
+func (r bracketRenderer) Render() string {
+	if r.bracket == nil || r.bracket.TotalRounds == 0 {
+		return ""
+	}
+
+	var columns []string
+	for round := r.firstRound; round <= r.lastRound; round++ {
+		columns = append(columns, r.renderRound(round))
+		if round < r.lastRound {
+			columns = append(columns, r.renderConnectors(round))
+		}
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+}
+
+// renderRound renders one round column: a header followed by match boxes
+// placed at their anchor rows. The final column also holds the third-place match.
+func (r bracketRenderer) renderRound(round int) string {
+	lines := []string{r.renderRoundHeader(round), ""}
+
+	layout := r.layout()
+	y := 0
+	for _, match := range r.bracket.MatchesInRound(round) {
+		top := r.anchor(round, match.Position) - layout.anchorRow
+		for ; y < top; y++ {
+			lines = append(lines, "")
+		}
+		lines = append(lines, r.renderMatch(match))
+		y = top + layout.boxHeight
+	}
+
+	if round == r.bracket.TotalRounds-1 {
+		if thirdPlace := r.bracket.ThirdPlaceMatch(); thirdPlace != nil {
+			name := matchRoundName(r.bracket, thirdPlace)
+			if r.zoom == ZoomMinimap {
+				name = i18n.T("3rd")
+			}
+			header := bracketRoundHeaderStyle.Width(r.columnWidth()).Render(name)
+			lines = append(lines, "", header, r.renderMatch(thirdPlace))
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func (r bracketRenderer) renderRoundHeader(round int) string {
+	name := roundLabel(round, r.bracket.TotalRounds)
+	if r.zoom == ZoomMinimap {
+		name = i18n.T("R%d", round+1)
+	}
+	return bracketRoundHeaderStyle.Width(r.columnWidth()).Render(name)
+}
+
+// width returns the width of the bracket as drawn.
+func (r bracketRenderer) width() int {
+	rounds := r.lastRound - r.firstRound + 1
+	return rounds*r.columnWidth() + (rounds-1)*r.layout().connectorWidth
+}
+
+// renderRoundTabs names every round, highlighting the ones being drawn.
+func (r bracketRenderer) renderRoundTabs() string {
+	var tabs []string
+	for round := 0; round < r.bracket.TotalRounds; round++ {
+		style := bracketTabStyle
+		if round >= r.firstRound && round <= r.lastRound {
+			style = bracketActiveTabStyle
+		}
+		tabs = append(tabs, style.Render(roundLabel(round, r.bracket.TotalRounds)))
+	}
+	return strings.Join(tabs, " ")
+}
+
+// columnWidth returns the width of a round column at the current zoom.
+func (r bracketRenderer) columnWidth() int {
+	switch r.zoom {
+	case ZoomCompact:
+		return 2 + r.nameWidth()*2 + 3 // Status swatch, then "A v B"
+	case ZoomMinimap:
+		return r.nameWidth()
+	default:
+		return r.contentWidth() + 4 // Padding and border
+	}
+}
+
+// matchBounds returns where a match is drawn in the rendered bracket: its
+// top-left cell, width and height.
+func (r bracketRenderer) matchBounds(match *Match) (x, y, width, height int) {
+	layout := r.layout()
+	x = (match.Round - r.firstRound) * (r.columnWidth() + layout.connectorWidth)
+	position := match.Position
+	if match.IsThirdPlace {
+		// Below the final, after a blank line and its own header
+		position = 0
+	}
+	y = roundHeaderRows + r.anchor(match.Round, position) - layout.anchorRow
+	if match.IsThirdPlace {
+		y += layout.boxHeight + 2
+	}
+	return x, y, r.columnWidth(), layout.boxHeight
+}
+
+// renderMatch renders a match at the current zoom.
+func (r bracketRenderer) renderMatch(match *Match) string {
+	switch r.zoom {
+	case ZoomCompact:
+		return r.renderMatchLine(match)
+	case ZoomMinimap:
+		return r.renderMatchWinner(match)
+	default:
+		return r.renderMatchBox(match)
+	}
+}
+
+// contentWidth returns the width inside a match box's padding.
+func (r bracketRenderer) contentWidth() int {
+	if r.schedule != nil {
+		return r.nameWidth() + slotInfoWidth
+	}
+	return r.nameWidth()
+}
+
+// nameWidth returns the columns each name gets at the current zoom: room
+// for the longest participant's name, capped tighter when zoomed out. Full
+// boxes also grow to fit the round names above them.
+func (r bracketRenderer) nameWidth() int {
+	width := r.names.Width(r.bracket.Participants)
+	switch r.zoom {
+	case ZoomCompact:
+		return min(width, compactNameWidth)
+	case ZoomMinimap:
+		return min(width, minimapNameWidth)
+	default:
+		header := r.headerWidth() - 4 // Padding and border
+		if r.schedule != nil {
+			header -= slotInfoWidth
+		}
+		return max(width, header)
+	}
+}
+
+// headerWidth returns the width of the longest round name in the bracket.
+func (r bracketRenderer) headerWidth() int {
+	width := 0
+	for round := 0; round < r.bracket.TotalRounds; round++ {
+		width = max(width, NameWidth(roundLabel(round, r.bracket.TotalRounds)))
+	}
+	if thirdPlace := r.bracket.ThirdPlaceMatch(); thirdPlace != nil {
+		width = max(width, NameWidth(matchRoundName(r.bracket, thirdPlace)))
+	}
+	return width
+}
+
+// renderMatchBox renders a match as a bordered box with one player per line.
+func (r bracketRenderer) renderMatchBox(match *Match) string {
+	style := bracketMatchStyle.BorderForeground(bracketStatusColors[match.Status])
+	switch {
+	case match.ID == r.selectedMatchID:
+		style = bracketSelectedMatchStyle
+	case r.path != nil && r.path.Contains(match.ID):
+		style = bracketPathMatchStyle.BorderForeground(bracketStatusColors[match.Status])
+	case r.path != nil && r.path.Ahead(match.ID):
+		style = bracketRouteMatchStyle.BorderForeground(bracketTracedStyle.GetForeground())
+	}
+
+	player1 := r.renderPlayer(match, match.Player1)
+	player2 := r.renderPlayer(match, match.Player2)
+
+	// Undecided matches show their estimated start time and court
+	if r.schedule != nil {
+		var startText, courtText string
+		if slot, ok := r.schedule.ForMatch(match.ID); ok && !match.Status.IsDecided() {
+			startText = slot.Start.Format("15:04")
+			courtText = i18n.T("Ct %d", slot.Court+1)
+		}
+		nameStyle := lipgloss.NewStyle().Width(r.nameWidth())
+		infoStyle := bracketPlaceholderStyle.Width(slotInfoWidth).Align(lipgloss.Right)
+		player1 = nameStyle.Render(player1) + infoStyle.Render(startText)
+		player2 = nameStyle.Render(player2) + infoStyle.Render(courtText)
+	}
+
+	return style.Width(r.contentWidth() + 2).Render(player1 + "\n" + player2)
+}
+
+// renderMatchLine renders a match on one line, with a status swatch in place
+// of the border. The swatch is hollow on a traced player's route.
+func (r bracketRenderer) renderMatchLine(match *Match) string {
+	mark := "■"
+	if r.path != nil && r.path.Ahead(match.ID) {
+		mark = "□" // On the traced player's route
+	}
+	swatch := lipgloss.NewStyle().Foreground(bracketStatusColors[match.Status]).Render(mark)
+	width := r.nameWidth()
+	nameStyle := lipgloss.NewStyle().Width(width)
+	line := swatch + " " +
+		nameStyle.Render(r.renderPlayerName(match, match.Player1, width)) + " v " +
+		nameStyle.Render(r.renderPlayerName(match, match.Player2, width))
+	if match.ID == r.selectedMatchID {
+		return bracketSelectedLineStyle.Render(line)
+	}
+	return line
+}
+
+// renderMatchWinner renders only the winner of a match, or a dot until it is
+// decided. The dot is highlighted on a traced player's route.
+func (r bracketRenderer) renderMatchWinner(match *Match) string {
+	text := bracketPlaceholderStyle.Render("·")
+	if r.path != nil && r.path.Ahead(match.ID) {
+		text = bracketTracedStyle.Render("·")
+	}
+	if match.Winner != nil {
+		style := lipgloss.NewStyle().Foreground(bracketStatusColors[match.Status])
+		if r.traces(match.Winner) {
+			style = bracketTracedStyle
+		}
+		text = style.Render(r.names.Fit(match.Winner, r.nameWidth()))
+	}
+	text = lipgloss.NewStyle().Width(r.nameWidth()).Render(text)
+	if match.ID == r.selectedMatchID {
+		return bracketSelectedLineStyle.Render(text)
+	}
+	return text
+}
+
+// renderPlayer renders one player slot, marking the winner and loser once decided.
+func (r bracketRenderer) renderPlayer(match *Match, player *Player) string {
+	return r.renderPlayerName(match, player, r.nameWidth())
+}
+
+// renderPlayerName renders a player slot with the name fitted to width.
+func (r bracketRenderer) renderPlayerName(match *Match, player *Player, width int) string {
+	if player == nil {
+		if match.IsBye {
+			return bracketPlaceholderStyle.Render(i18n.T("bye"))
+		}
+		return bracketPlaceholderStyle.Render(i18n.T("TBD"))
+	}
+
+	style := lipgloss.NewStyle()
+	switch {
+	case match.Winner == nil || match.IsBye:
+	case match.Winner == player:
+		style = bracketWinnerStyle
+	default:
+		style = bracketLoserStyle
+	}
+	if r.traces(player) {
+		// Keep the strikethrough of a loss
+		style = bracketTracedStyle.Inherit(style)
+	}
+	return style.Render(r.names.Fit(player, width))
+}
+
+// traces reports whether player is the one whose path is highlighted.
+func (r bracketRenderer) traces(player *Player) bool {
+	return r.path != nil && player != nil && player.ID == r.path.Player.ID
+}
+
+// renderLegend renders a key explaining the match status colours.
+func (r bracketRenderer) renderLegend() string {
+	statuses := []MatchStatus{MatchScheduled, MatchReady, MatchInProgress, MatchCompleted, MatchWalkover}
+
+	var items []string
+	for _, status := range statuses {
+		swatch := lipgloss.NewStyle().Foreground(bracketStatusColors[status]).Render("■")
+		items = append(items, swatch+" "+i18n.T(status.String()))
+	}
+	return strings.Join(items, "   ")
+}
+
+// renderConnectors draws the lines joining each pair of matches in a round
+// to the match their winners meet in.
+func (r bracketRenderer) renderConnectors(round int) string {
+	layout := r.layout()
+	height := roundHeaderRows + len(r.bracket.MatchesInRound(r.firstRound))*layout.slotHeight
+	lines := make([]string, height)
+	for i := range lines {
+		lines[i] = strings.Repeat(" ", layout.connectorWidth)
+	}
+	if layout.connectorWidth < 4 {
+		return strings.Join(lines, "\n")
+	}
+
+	for _, next := range r.bracket.MatchesInRound(round + 1) {
+		from := roundHeaderRows + r.anchor(round, next.Position*2)
+		to := roundHeaderRows + r.anchor(round, next.Position*2+1)
+		mid := roundHeaderRows + r.anchor(round+1, next.Position)
+
+		lines[from] = "──┐ "
+		for y := from + 1; y < to; y++ {
+			lines[y] = "  │ "
+		}
+		lines[mid] = "  ├─"
+		lines[to] = "──┘ "
+	}
+
+	return bracketConnectorStyle.Render(strings.Join(lines, "\n"))
+}
+
+// anchor returns the row, relative to the top of the first match, that a
+// match's connector line attaches to. Matches in the first round drawn are
+// stacked one slot apart; later matches sit midway between their two feeders.
+func (r bracketRenderer) anchor(round, position int) int {
+	layout := r.layout()
+	if round <= r.firstRound {
+		return position*layout.slotHeight + layout.anchorRow
+	}
+	return (r.anchor(round-1, position*2) + r.anchor(round-1, position*2+1)) / 2
+}
+
+// matchupText returns "Player A vs Player B" with TBD for unknown players.
+// Names are truncated to width, or written in full when width is 0.
+func matchupText(match *Match, width int) string {
+	name := func(player *Player) string {
+		if player == nil {
+			return i18n.T("TBD")
+		}
+		if width > 0 {
+			return truncateName(player.Name, width)
+		}
+		return player.Name
+	}
+	return i18n.T("%s vs %s", name(match.Player1), name(match.Player2))
+}
+
+// matchRoundName returns the round label for a match, naming the third-place playoff.
+func matchRoundName(bracket *Bracket, match *Match) string {
+	if match.IsThirdPlace {
+		return i18n.T("3rd Place")
+	}
+	return roundLabel(match.Round, bracket.TotalRounds)
+}
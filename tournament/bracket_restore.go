@@ -0,0 +1,106 @@
+package tournament
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// MatchState is the recorded progress of one match: enough to bring a
+// regenerated bracket back to where it was saved.
+type MatchState struct {
+	MatchID     int
+	Status      MatchStatus
+	Player1ID   int // -1 if the slot is empty
+	Player2ID   int // -1 if the slot is empty
+	WinnerID    int // -1 if undecided
+	StartedAt   time.Time
+	CompletedAt time.Time
+}
+
+// State returns the recorded progress of every match, in match ID order.
+func (b *Bracket) State() []MatchState {
+	states := make([]MatchState, len(b.Matches))
+	for i, match := range b.Matches {
+		states[i] = MatchState{
+			MatchID:     match.ID,
+			Status:      match.Status,
+			Player1ID:   slotID(match.Player1),
+			Player2ID:   slotID(match.Player2),
+			WinnerID:    -1,
+			StartedAt:   match.StartedAt,
+			CompletedAt: match.CompletedAt,
+		}
+		if match.Winner != nil {
+			states[i].WinnerID = match.Winner.ID
+		}
+	}
+	return states
+}
+
+// RestoreBracket rebuilds a saved bracket. The draw is regenerated from the
+// participants' seeds, then results are replayed in match order so players
+// advance exactly as they did, and the saved timestamps are put back.
+// Replaying fills each match's slots in match order, whatever order the
+// results came in, so players are put back in their saved slots as well.
+func RestoreBracket(participants []Player, states []MatchState, opts ...BracketOption) (*Bracket, error) {
+	if len(participants) < 2 {
+		return nil, ErrTooFewParticipants
+	}
+
+	var options bracketOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	players := copyParticipants(participants)
+	sort.SliceStable(players, func(i, j int) bool {
+		return players[i].Seed < players[j].Seed
+	})
+	b := buildBracket(players, options)
+
+	states = append([]MatchState(nil), states...)
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].MatchID < states[j].MatchID
+	})
+
+	for _, state := range states {
+		match, err := b.MatchByID(state.MatchID)
+		if err != nil {
+			return nil, fmt.Errorf("restore: %w", err)
+		}
+		// Every match feeding this one has been replayed by now
+		if state.Player1ID != state.Player2ID &&
+			slotID(match.Player1) == state.Player2ID && slotID(match.Player2) == state.Player1ID {
+			match.Player1, match.Player2 = match.Player2, match.Player1
+		}
+
+		switch state.Status {
+		case MatchCompleted, MatchWalkover:
+			if err := b.decideMatch(state.MatchID, state.WinnerID, state.Status); err != nil {
+				return nil, fmt.Errorf("restore: %w", err)
+			}
+		case MatchInProgress:
+			if err := b.StartMatch(state.MatchID); err != nil {
+				return nil, fmt.Errorf("restore: %w", err)
+			}
+		}
+
+		if match.Status != state.Status {
+			return nil, fmt.Errorf("restore match %d: saved as %s but draws as %s: %w",
+				state.MatchID, state.Status, match.Status, ErrInvalidTransition)
+		}
+		match.StartedAt = state.StartedAt
+		match.CompletedAt = state.CompletedAt
+	}
+
+	return b, nil
+}
+
+// slotID returns the ID of the player in a match slot, or -1 if it is empty.
+func slotID(p *Player) int {
+	if p == nil {
+		return -1
+	}
+	return p.ID
+}
@@ -0,0 +1,32 @@
+package tournament
+
+import "testing"
+
+func TestRestoreKeepsSlotsOfResultsRecordedOutOfOrder(t *testing.T) {
+	bracket, err := NewBracketWithPlayers([]Player{{Name: "A"}, {Name: "B"}, {Name: "C"}, {Name: "D"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	semis := bracket.MatchesInRound(0)
+	if len(semis) != 2 {
+		t.Fatalf("got %d first-round matches, want 2", len(semis))
+	}
+	// The second semifinal finishes first, so its winner takes the final's first slot
+	for _, semi := range []*Match{semis[1], semis[0]} {
+		if err := bracket.RecordResult(semi.ID, semi.Player1.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	restored, err := RestoreBracket(bracket.Participants, bracket.State())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, match := range bracket.Matches {
+		got := restored.Matches[i]
+		if slotID(got.Player1) != slotID(match.Player1) || slotID(got.Player2) != slotID(match.Player2) {
+			t.Errorf("match %d restored as %d v %d, want %d v %d", match.ID,
+				slotID(got.Player1), slotID(got.Player2), slotID(match.Player1), slotID(match.Player2))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package tournament
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// viewport is a window onto a rendered bracket that may be larger than the
+// terminal. The offset is kept in range by clamp, so callers can scroll freely.
+type viewport struct {
+	x, y          int // Top-left cell shown
+	width, height int // Visible size (0 shows everything)
+}
+
+// clamp keeps the window inside content of the given size.
+func (v *viewport) clamp(contentWidth, contentHeight int) {
+	v.x = max(min(v.x, contentWidth-v.width), 0)
+	v.y = max(min(v.y, contentHeight-v.height), 0)
+}
+
+// reveal scrolls as little as possible so the given cells are visible,
+// preferring the top-left corner when they do not fit.
+func (v *viewport) reveal(x, y, width, height int) {
+	if v.width > 0 {
+		if x+width > v.x+v.width {
+			v.x = x + width - v.width
+		}
+		if x < v.x {
+			v.x = x
+		}
+	}
+	if v.height > 0 {
+		if y+height > v.y+v.height {
+			v.y = y + height - v.height
+		}
+		if y < v.y {
+			v.y = y
+		}
+	}
+}
+
+// crop returns the visible part of content. Styles are kept intact and wide
+// characters are never split.
+func (v viewport) crop(content string) string {
+	lines := strings.Split(content, "\n")
+	if v.height > 0 && len(lines) > v.height {
+		lines = lines[min(v.y, len(lines)-v.height):][:v.height]
+	}
+	if v.width > 0 {
+		for i, line := range lines {
+			if ansi.StringWidth(line) > v.width || v.x > 0 {
+				lines[i] = ansi.Cut(line, v.x, v.x+v.width)
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,230 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"go-tournament/i18n"
+)
+
+var (
+	// ErrCourtNotFound is returned when a court ID does not exist.
+	ErrCourtNotFound = errors.New("court not found")
+
+	// ErrCourtBusy is returned when assigning to a court that already has a match.
+	ErrCourtBusy = errors.New("court already has a match")
+
+	// ErrNoMatchReady is returned when no queued match can be called to a court.
+	ErrNoMatchReady = errors.New("no match ready to call")
+
+	// ErrNoCourtFree is returned when a match is started by hand while every court is in use.
+	ErrNoCourtFree = errors.New("every court is in use")
+)
+
+// Court is a court or table that matches are played on.
+type Court struct {
+	ID      int    // Index of the court (0-indexed)
+	Name    string // Display name, e.g. "Court 1"
+	MatchID int    // Match being played on the court (-1 when free)
+}
+
+// IsFree reports whether the court has no match assigned.
+func (c Court) IsFree() bool {
+	return c.MatchID == -1
+}
+
+// CourtManager calls ready matches from a bracket onto a fixed set of courts.
+// Matches are queued by round and position; calling a match starts it, and a
+// court frees up as soon as its match is decided. A match stopped by hand is
+// held back rather than called straight back onto the court it left.
+type CourtManager struct {
+	bracket *Bracket
+	courts  []Court
+	held    map[int]bool // Matches stopped by hand, not called until started again
+}
+
+// NewCourtManager creates a manager for count courts named "Court 1".."Court n".
+func NewCourtManager(bracket *Bracket, count int) *CourtManager {
+	courts := make([]Court, count)
+	for i := range courts {
+		courts[i] = Court{
+			ID:      i,
+			Name:    i18n.T("Court %d", i+1),
+			MatchID: -1,
+		}
+	}
+	return &CourtManager{
+		bracket: bracket,
+		courts:  courts,
+		held:    make(map[int]bool),
+	}
+}
+
+// clone returns a copy of the manager that follows bracket, a copy of its own.
+func (c *CourtManager) clone(bracket *Bracket) *CourtManager {
+	held := make(map[int]bool, len(c.held))
+	for matchID := range c.held {
+		held[matchID] = true
+	}
+	return &CourtManager{bracket: bracket, courts: c.Courts(), held: held}
+}
+
+// Hold keeps a match off the courts until Release, e.g. after it was stopped
+// by hand so the court it freed goes to the next match instead.
+func (c *CourtManager) Hold(matchID int) {
+	c.held[matchID] = true
+}
+
+// Release lets a held match be called onto a court again.
+func (c *CourtManager) Release(matchID int) {
+	delete(c.held, matchID)
+}
+
+// IsHeld reports whether a match is kept off the courts.
+func (c *CourtManager) IsHeld(matchID int) bool {
+	return c.held[matchID]
+}
+
+// Courts returns a snapshot of all courts.
+func (c *CourtManager) Courts() []Court {
+	courts := make([]Court, len(c.courts))
+	copy(courts, c.courts)
+	return courts
+}
+
+// CourtForMatch returns the court a match is assigned to.
+func (c *CourtManager) CourtForMatch(matchID int) (Court, bool) {
+	for _, court := range c.courts {
+		if court.MatchID == matchID {
+			return court, true
+		}
+	}
+	return Court{}, false
+}
+
+// Occupy puts a match that is already being played onto a court, e.g. when a
+// saved tournament is reopened.
+func (c *CourtManager) Occupy(courtID, matchID int) error {
+	if courtID < 0 || courtID >= len(c.courts) {
+		return fmt.Errorf("court %d: %w", courtID, ErrCourtNotFound)
+	}
+	court := &c.courts[courtID]
+	if !court.IsFree() {
+		return fmt.Errorf("%s: %w", court.Name, ErrCourtBusy)
+	}
+	match, err := c.bracket.MatchByID(matchID)
+	if err != nil {
+		return err
+	}
+	if match.Status != MatchInProgress {
+		return fmt.Errorf("occupy %s with match %d (%s): %w", court.Name, matchID, match.Status, ErrInvalidTransition)
+	}
+
+	court.MatchID = matchID
+	return nil
+}
+
+// Start starts a match by hand on the first free court, releasing it if it
+// was held. Unlike AssignNext it takes the match asked for, even ahead of the
+// queue, but refuses when no court is free rather than play it off court.
+func (c *CourtManager) Start(matchID int) (Court, error) {
+	c.release()
+	for i := range c.courts {
+		court := &c.courts[i]
+		if !court.IsFree() {
+			continue
+		}
+		if err := c.bracket.StartMatch(matchID); err != nil {
+			return Court{}, err
+		}
+		court.MatchID = matchID
+		c.Release(matchID)
+		return *court, nil
+	}
+	return Court{}, fmt.Errorf("start match %d: %w", matchID, ErrNoCourtFree)
+}
+
+// Queue returns the ready matches waiting for a court, ordered by round and then position.
+func (c *CourtManager) Queue() []*Match {
+	queue := c.bracket.MatchesWithStatus(MatchReady)
+	sort.SliceStable(queue, func(i, j int) bool {
+		if queue[i].Round != queue[j].Round {
+			return queue[i].Round < queue[j].Round
+		}
+		return queue[i].Position < queue[j].Position
+	})
+	return queue
+}
+
+// AssignNext calls the first queued match whose players are not already on
+// court, and which is not held, to the given court and starts it.
+func (c *CourtManager) AssignNext(courtID int) (*Match, error) {
+	if courtID < 0 || courtID >= len(c.courts) {
+		return nil, fmt.Errorf("court %d: %w", courtID, ErrCourtNotFound)
+	}
+	court := &c.courts[courtID]
+	if !court.IsFree() {
+		return nil, fmt.Errorf("%s: %w", court.Name, ErrCourtBusy)
+	}
+
+	for _, match := range c.Queue() {
+		if c.held[match.ID] || c.playerBusy(match.Player1) || c.playerBusy(match.Player2) {
+			continue
+		}
+		if err := c.bracket.StartMatch(match.ID); err != nil {
+			return nil, err
+		}
+		court.MatchID = match.ID
+		return match, nil
+	}
+
+	return nil, ErrNoMatchReady
+}
+
+// Refresh frees courts whose matches are no longer being played and fills
+// every free court from the queue. It returns the matches that were called.
+func (c *CourtManager) Refresh() []*Match {
+	c.release()
+
+	var called []*Match
+	for i := range c.courts {
+		if !c.courts[i].IsFree() {
+			continue
+		}
+		match, err := c.AssignNext(i)
+		if err != nil {
+			break
+		}
+		called = append(called, match)
+	}
+	return called
+}
+
+// release frees courts whose match was decided, stopped or redrawn away.
+func (c *CourtManager) release() {
+	for i := range c.courts {
+		court := &c.courts[i]
+		if court.IsFree() {
+			continue
+		}
+		match, err := c.bracket.MatchByID(court.MatchID)
+		if err != nil || match.Status != MatchInProgress {
+			court.MatchID = -1
+		}
+	}
+}
+
+// playerBusy reports whether a player is in any match currently being played,
+// whether or not it was called through the manager.
+func (c *CourtManager) playerBusy(player *Player) bool {
+	if player == nil {
+		return false
+	}
+	for _, match := range c.bracket.MatchesWithStatus(MatchInProgress) {
+		if match.Player1 == player || match.Player2 == player {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package tournament
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStoppedMatchLeavesCourt(t *testing.T) {
+	bracket, err := NewBracketWithPlayers([]Player{{Name: "A"}, {Name: "B"}, {Name: "C"}, {Name: "D"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := NewStore()
+	store.Update(func(tx *Txn) error {
+		tx.Create(Tournament{Bracket: bracket, Courts: NewCourtManager(bracket, 1)})
+		tx.RefreshCourts()
+		return nil
+	})
+
+	var called int
+	store.View(func(tr Tournament) {
+		called = tr.Courts.Courts()[0].MatchID
+	})
+	if called == -1 {
+		t.Fatal("no match called onto the court")
+	}
+
+	store.Update(func(tx *Txn) error {
+		if err := tx.StopMatch(called); err != nil {
+			t.Fatal(err)
+		}
+		tx.RefreshCourts()
+		return nil
+	})
+
+	store.View(func(tr Tournament) {
+		match, _ := tr.Bracket.MatchByID(called)
+		if match.Status != MatchReady {
+			t.Errorf("stopped match is %s, want ready", match.Status)
+		}
+		if court := tr.Courts.Courts()[0]; court.MatchID == called {
+			t.Errorf("stopped match %d was called straight back onto %s", called, court.Name)
+		}
+	})
+
+	// The court went to the next match, so there is nowhere to start it by hand
+	err = store.Update(func(tx *Txn) error {
+		return tx.StartMatch(called)
+	})
+	if !errors.Is(err, ErrNoCourtFree) {
+		t.Fatalf("starting with every court in use: got %v, want %v", err, ErrNoCourtFree)
+	}
+
+	// Once the court frees up, starting it by hand puts it there
+	store.Update(func(tx *Txn) error {
+		other := tx.Tournament().Courts.Courts()[0].MatchID
+		match, _ := tx.Bracket().MatchByID(other)
+		if err := tx.RecordResult(other, match.Player1.ID); err != nil {
+			t.Fatal(err)
+		}
+		return tx.StartMatch(called)
+	})
+	store.View(func(tr Tournament) {
+		if tr.Courts.IsHeld(called) {
+			t.Errorf("match %d still held after being started by hand", called)
+		}
+		if court, ok := tr.Courts.CourtForMatch(called); !ok {
+			t.Errorf("match %d started by hand is not on a court", called)
+		} else if court.ID != 0 {
+			t.Errorf("match %d started on %s, want the free court", called, court.Name)
+		}
+	})
+}
@@ -0,0 +1,324 @@
+package tournament
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"go-tournament/i18n"
+)
+
+const (
+	icalTimeFormat = "20060102T150405Z"
+	icalLineLimit  = 75 // Octets per content line before folding (RFC 5545 §3.1)
+)
+
+// icalOptions holds optional calendar export settings.
+type icalOptions struct {
+	playerID     int
+	filterPlayer bool
+	calendarName string
+	stamp        time.Time
+}
+
+// ICalOption configures a calendar export.
+type ICalOption func(*icalOptions)
+
+// ForPlayer limits the calendar to matches the given player is known to be playing.
+// Matches they may reach later appear once earlier results put them there.
+func ForPlayer(playerID int) ICalOption {
+	return func(o *icalOptions) {
+		o.playerID = playerID
+		o.filterPlayer = true
+	}
+}
+
+// WithCalendarName sets the calendar's display name.
+func WithCalendarName(name string) ICalOption {
+	return func(o *icalOptions) {
+		o.calendarName = name
+	}
+}
+
+// WithTimestamp sets the DTSTAMP written on every event. Defaults to the current time.
+func WithTimestamp(stamp time.Time) ICalOption {
+	return func(o *icalOptions) {
+		o.stamp = stamp
+	}
+}
+
+// WriteICalendar writes the scheduled matches of a tournament to w as an
+// iCalendar (RFC 5545) feed with one VEVENT per match. Each event has a UID
+// made from the tournament and match IDs, so re-exporting after results come
+// in updates existing calendar entries in place without touching another
+// tournament's. Events are placed on the tournament's named courts when it
+// manages them.
+func WriteICalendar(w io.Writer, t Tournament, schedule *Schedule, opts ...ICalOption) error {
+	bracket := t.Bracket
+	options := icalOptions{
+		calendarName: i18n.T("Tournament"),
+		stamp:        time.Now(),
+	}
+	if t.Name != "" {
+		options.calendarName = t.Name
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	bw := bufio.NewWriter(w)
+	writeICalLine(bw, "BEGIN:VCALENDAR")
+	writeICalLine(bw, "VERSION:2.0")
+	writeICalLine(bw, "PRODID:-//go-tournament//Tournament Manager//EN")
+	writeICalLine(bw, "CALSCALE:GREGORIAN")
+	writeICalLine(bw, "METHOD:PUBLISH")
+	writeICalLine(bw, "X-WR-CALNAME:"+escapeICalText(options.calendarName))
+
+	for _, slot := range schedule.Slots {
+		match, err := bracket.MatchByID(slot.MatchID)
+		if err != nil {
+			return err
+		}
+		if options.filterPlayer && !matchHasPlayer(match, options.playerID) {
+			continue
+		}
+
+		summary := fmt.Sprintf("%s: %s", matchRoundName(bracket, match), matchupText(match, 0))
+		court := i18n.T("Court %d", slot.Court+1)
+		if t.Courts != nil && slot.Court < len(t.Courts.Courts()) {
+			court = t.Courts.Courts()[slot.Court].Name
+		}
+		description := summary + "\n" + i18n.T("Status: %s", i18n.T(match.Status.String()))
+		if match.Winner != nil {
+			description += "\n" + i18n.T("Winner: %s", match.Winner.Name)
+		}
+
+		writeICalLine(bw, "BEGIN:VEVENT")
+		writeICalLine(bw, fmt.Sprintf("UID:tournament-%d-match-%d@go-tournament", t.ID, match.ID))
+		writeICalLine(bw, "DTSTAMP:"+options.stamp.UTC().Format(icalTimeFormat))
+		writeICalLine(bw, "DTSTART:"+slot.Start.UTC().Format(icalTimeFormat))
+		writeICalLine(bw, "DTEND:"+slot.End.UTC().Format(icalTimeFormat))
+		writeICalLine(bw, "SUMMARY:"+escapeICalText(summary))
+		writeICalLine(bw, "LOCATION:"+escapeICalText(court))
+		writeICalLine(bw, "DESCRIPTION:"+escapeICalText(description))
+		writeICalLine(bw, "END:VEVENT")
+	}
+
+	writeICalLine(bw, "END:VCALENDAR")
+	return bw.Flush()
+}
+
+// ExportCalendars writes the full tournament feed and one filtered feed per
+// player into dir, creating it if needed. Files are named tournament.ics and
+// player-<ID>.ics so subscribers keep the same URL across re-exports.
+func ExportCalendars(dir string, t Tournament, schedule *Schedule) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	stamp := time.Now()
+	if err := writeICalendarFile(filepath.Join(dir, "tournament.ics"), t, schedule,
+		WithTimestamp(stamp)); err != nil {
+		return err
+	}
+
+	for _, player := range t.Bracket.Participants {
+		path := filepath.Join(dir, fmt.Sprintf("player-%d.ics", player.ID))
+		if err := writeICalendarFile(path, t, schedule,
+			ForPlayer(player.ID),
+			WithCalendarName(i18n.T("Tournament – %s", player.Name)),
+			WithTimestamp(stamp)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeICalendarFile writes a calendar feed to path, replacing any existing file.
+func writeICalendarFile(path string, t Tournament, schedule *Schedule, opts ...ICalOption) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteICalendar(f, t, schedule, opts...); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// matchHasPlayer reports whether playerID is one of the match's known players.
+func matchHasPlayer(match *Match, playerID int) bool {
+	return (match.Player1 != nil && match.Player1.ID == playerID) ||
+		(match.Player2 != nil && match.Player2.ID == playerID)
+}
+
+// escapeICalText escapes a TEXT value: backslashes, semicolons, commas and newlines.
+func escapeICalText(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+	).Replace(text)
+}
+
+// writeICalLine writes a content line terminated by CRLF, folding it onto
+// continuation lines so none exceeds the line limit. Folds never split a
+// multi-byte character. Write errors surface from the final Flush.
+func writeICalLine(w *bufio.Writer, line string) {
+	limit := icalLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icalLineLimit - 1 // Continuation lines start with a space
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+// ExportFailedMsg reports calendar feeds that could not be kept up to date.
+type ExportFailedMsg struct {
+	Err error
+}
+
+// CalendarFeeds keeps the iCalendar feeds of a store's tournament up to date
+// in a directory. Once enabled, feeds are rewritten after every change to the
+// store, whoever made it. Writes run on a goroutine of their own, so changes
+// never wait on the disk; changes made while a write is running are picked up
+// by the next one.
+type CalendarFeeds struct {
+	store *Store
+	dir   string
+
+	mu          sync.Mutex // Serializes writes and guards the fields below
+	onError     func(error)
+	unsubscribe func() // Set while following the store
+	closed      bool
+
+	due  chan struct{} // Holds a value while the store has changes not yet written
+	quit chan struct{} // Closed by Close
+	done chan struct{} // Closed once run returns
+}
+
+// NewCalendarFeeds creates feeds for the store's tournament, written into dir
+// once enabled.
+func NewCalendarFeeds(store *Store, dir string) *CalendarFeeds {
+	return &CalendarFeeds{
+		store: store,
+		dir:   dir,
+		due:   make(chan struct{}, 1),
+		quit:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+}
+
+// Dir returns the directory the feeds are written into.
+func (f *CalendarFeeds) Dir() string {
+	return f.dir
+}
+
+// OnError registers a callback for feeds that could not be rewritten after a
+// change, e.g. to tell the organizer.
+func (f *CalendarFeeds) OnError(fn func(error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.onError = fn
+}
+
+// Enable writes the feeds and keeps them up to date from then on. Enabling
+// feeds that are already enabled just rewrites them.
+func (f *CalendarFeeds) Enable() error {
+	f.mu.Lock()
+	if f.unsubscribe == nil && !f.closed {
+		f.unsubscribe = f.store.Subscribe(func(Event) {
+			select {
+			case f.due <- struct{}{}:
+			default: // A write is already due and will see this change too
+			}
+		})
+		go f.run()
+	}
+	f.mu.Unlock()
+	return f.write()
+}
+
+// Close stops following the store, waiting for a write still due to finish.
+// Feeds enabled after Close are written once but no longer kept up to date.
+func (f *CalendarFeeds) Close() {
+	f.mu.Lock()
+	unsubscribe := f.unsubscribe
+	f.unsubscribe, f.closed = nil, true
+	f.mu.Unlock()
+	if unsubscribe == nil {
+		return
+	}
+	unsubscribe()
+	close(f.quit)
+	<-f.done
+}
+
+// run rewrites the feeds whenever a write is due, until Close is called.
+func (f *CalendarFeeds) run() {
+	defer close(f.done)
+	for {
+		select {
+		case <-f.due:
+			f.rewrite()
+		case <-f.quit:
+			select {
+			case <-f.due:
+				f.rewrite()
+			default:
+			}
+			return
+		}
+	}
+}
+
+// rewrite writes the feeds after a change, passing any error to onError.
+func (f *CalendarFeeds) rewrite() {
+	err := f.write()
+	if err == nil || errors.Is(err, ErrNoBracket) {
+		return
+	}
+	f.mu.Lock()
+	onError := f.onError
+	f.mu.Unlock()
+	if onError != nil {
+		onError(err)
+	}
+}
+
+// write exports the feeds from a snapshot of the store's tournament.
+func (f *CalendarFeeds) write() error {
+	var t Tournament
+	f.store.View(func(current Tournament) {
+		if current.Bracket != nil {
+			t = current.Snapshot()
+		}
+	})
+	if t.Bracket == nil {
+		return ErrNoBracket
+	}
+	schedule, err := scheduleOf(t)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return ExportCalendars(f.dir, t, schedule)
+}
@@ -0,0 +1,105 @@
+package tournament
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestWriteICalLineFoldsMultibyteText(t *testing.T) {
+	line := "SUMMARY:" + strings.Repeat("결승전 김민준 대 이서연, ", 6)
+
+	var out strings.Builder
+	w := bufio.NewWriter(&out)
+	writeICalLine(w, line)
+	w.Flush()
+
+	physical := strings.Split(strings.TrimSuffix(out.String(), "\r\n"), "\r\n")
+	if len(physical) < 2 {
+		t.Fatalf("%d-octet line was not folded", len(line))
+	}
+	var unfolded strings.Builder
+	for i, part := range physical {
+		if len(part) > icalLineLimit {
+			t.Errorf("line %d is %d octets, want at most %d", i, len(part), icalLineLimit)
+		}
+		if i > 0 {
+			if !strings.HasPrefix(part, " ") {
+				t.Errorf("continuation line %d does not start with a space: %q", i, part)
+			}
+			part = part[1:]
+		}
+		if !utf8.ValidString(part) {
+			t.Errorf("line %d splits a character: %q", i, part)
+		}
+		unfolded.WriteString(part)
+	}
+	if unfolded.String() != line {
+		t.Errorf("unfolded line = %q, want %q", unfolded.String(), line)
+	}
+}
+
+func TestEscapeICalText(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"plain", "plain"},
+		{"a,b", `a\,b`},
+		{"a;b", `a\;b`},
+		{`a\b`, `a\\b`},
+		{"a\nb", `a\nb`},
+		{"a,b;c\\d\ne", `a\,b\;c\\d\ne`},
+	}
+	for _, tt := range tests {
+		if got := escapeICalText(tt.text); got != tt.want {
+			t.Errorf("escapeICalText(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCalendarFeedsFollowStore(t *testing.T) {
+	bracket, err := NewBracketWithPlayers([]Player{{Name: "A"}, {Name: "B"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := NewStore()
+	store.Update(func(tx *Txn) error {
+		tx.Create(Tournament{ID: 7, Name: "Club Open", Bracket: bracket,
+			Schedule: ScheduleConfig{Start: time.Now(), MatchDuration: 20 * time.Minute, Courts: 1}})
+		return nil
+	})
+
+	dir := t.TempDir()
+	feeds := NewCalendarFeeds(store, dir)
+	defer feeds.Close()
+	if err := feeds.Enable(); err != nil {
+		t.Fatal(err)
+	}
+	feed := readFeed(t, filepath.Join(dir, "tournament.ics"))
+	if !strings.Contains(feed, "X-WR-CALNAME:Club Open\r\n") {
+		t.Errorf("feed is not named after the tournament:\n%s", feed)
+	}
+
+	store.Update(func(tx *Txn) error {
+		return tx.RecordResult(bracket.Matches[0].ID, bracket.Participants[0].ID)
+	})
+	feeds.Close()
+	if feed := readFeed(t, filepath.Join(dir, "tournament.ics")); !strings.Contains(feed, "Winner: A") {
+		t.Errorf("feed was not rewritten after a result was recorded:\n%s", feed)
+	}
+}
+
+// readFeed reads a calendar feed with its folded lines joined back up.
+func readFeed(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.ReplaceAll(string(data), "\r\n ", "")
+}
@@ -0,0 +1,111 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MatchStatus is the lifecycle state of a match.
+type MatchStatus int
+
+const (
+	MatchScheduled  MatchStatus = iota // Waiting for one or both players
+	MatchReady                         // Both players known, not yet started
+	MatchInProgress                    // Currently being played
+	MatchCompleted                     // Decided by play
+	MatchWalkover                      // Decided without play, e.g. a no-show
+	MatchBye                           // Decided at generation; one player advances unopposed
+)
+
+// ErrInvalidTransition is returned when a match cannot move to the requested status.
+var ErrInvalidTransition = errors.New("invalid match status transition")
+
+// String returns a human-readable status name.
+func (s MatchStatus) String() string {
+	switch s {
+	case MatchScheduled:
+		return "Scheduled"
+	case MatchReady:
+		return "Ready"
+	case MatchInProgress:
+		return "In Progress"
+	case MatchCompleted:
+		return "Completed"
+	case MatchWalkover:
+		return "Walkover"
+	case MatchBye:
+		return "Bye"
+	default:
+		return fmt.Sprintf("MatchStatus(%d)", int(s))
+	}
+}
+
+// ParseMatchStatus returns the status whose String form is s.
+func ParseMatchStatus(s string) (MatchStatus, error) {
+	for status := MatchScheduled; status <= MatchBye; status++ {
+		if status.String() == s {
+			return status, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown match status %q", s)
+}
+
+// IsDecided reports whether the status means the match has a winner.
+func (s MatchStatus) IsDecided() bool {
+	return s == MatchCompleted || s == MatchWalkover || s == MatchBye
+}
+
+// StartMatch marks a ready match as being played.
+func (b *Bracket) StartMatch(matchID int) error {
+	match, err := b.MatchByID(matchID)
+	if err != nil {
+		return err
+	}
+	if match.Status != MatchReady {
+		return fmt.Errorf("start match %d (%s): %w", matchID, match.Status, ErrInvalidTransition)
+	}
+
+	match.Status = MatchInProgress
+	match.StartedAt = time.Now()
+	return nil
+}
+
+// StopMatch returns an in-progress match to ready, e.g. when it was called by mistake.
+func (b *Bracket) StopMatch(matchID int) error {
+	match, err := b.MatchByID(matchID)
+	if err != nil {
+		return err
+	}
+	if match.Status != MatchInProgress {
+		return fmt.Errorf("stop match %d (%s): %w", matchID, match.Status, ErrInvalidTransition)
+	}
+
+	match.Status = MatchReady
+	match.StartedAt = time.Time{}
+	return nil
+}
+
+// RecordWalkover awards a match without play. The winner advances as for a
+// normal result and the absent player is routed on like any other loser.
+func (b *Bracket) RecordWalkover(matchID, winnerID int) error {
+	return b.decideMatch(matchID, winnerID, MatchWalkover)
+}
+
+// MatchesWithStatus returns all matches currently in the given status, in bracket order.
+func (b *Bracket) MatchesWithStatus(status MatchStatus) []*Match {
+	var matches []*Match
+	for i := range b.Matches {
+		if b.Matches[i].Status == status {
+			matches = append(matches, &b.Matches[i])
+		}
+	}
+	return matches
+}
+
+// refreshStatus promotes a scheduled match to ready once both players are known.
+func refreshStatus(match *Match) {
+	if match.Status == MatchScheduled && match.Player1 != nil && match.Player2 != nil {
+		match.Status = MatchReady
+	}
+}
@@ -0,0 +1,86 @@
+package tournament
+
+import (
+	"strings"
+
+	"github.com/rivo/uniseg"
+)
+
+// NameLayout sets how wide the bracket draws player names. Name columns are
+// as wide as the longest participant's name, within the layout's limits.
+type NameLayout struct {
+	MinWidth int  `json:"min_width"` // Narrowest a name column is drawn, however short the names
+	MaxWidth int  `json:"max_width"` // Widest a name column grows; longer names end in an ellipsis
+	Aliases  bool `json:"aliases"`   // Show a player's short alias when their name does not fit
+}
+
+// DefaultNameLayout fits names of up to 14 columns and falls back to aliases.
+var DefaultNameLayout = NameLayout{MinWidth: 6, MaxWidth: 14, Aliases: true}
+
+// Width returns the name column width for the participants: wide enough for
+// the longest name, within the layout's limits.
+func (l NameLayout) Width(participants []Player) int {
+	longest := 0
+	for _, p := range participants {
+		longest = max(longest, NameWidth(p.Name))
+	}
+	return min(max(longest, l.MinWidth), l.MaxWidth)
+}
+
+// Fit returns the name to show for a player in width columns: the full name
+// when it fits, then the alias if the layout allows it and it fits, and
+// otherwise the name cut short with an ellipsis.
+func (l NameLayout) Fit(player *Player, width int) string {
+	if NameWidth(player.Name) <= width {
+		return player.Name
+	}
+	if l.Aliases && player.Alias != "" && NameWidth(player.Alias) <= width {
+		return player.Alias
+	}
+	return truncateName(player.Name, width)
+}
+
+// NameWidth returns the columns a name takes on screen. Each grapheme
+// cluster is measured as a whole, so Hangul and CJK characters take two
+// columns and an emoji built from several code points takes two, not one
+// per code point.
+func NameWidth(name string) int {
+	return uniseg.StringWidth(name)
+}
+
+// ShortestName returns the name that takes the fewest columns, or "" when
+// there are none.
+func ShortestName(names []string) string {
+	shortest := ""
+	for _, name := range names {
+		if name != "" && (shortest == "" || NameWidth(name) < NameWidth(shortest)) {
+			shortest = name
+		}
+	}
+	return shortest
+}
+
+// truncateName shortens a name to width columns, ending with an ellipsis
+// when cut. It never splits a grapheme cluster, and a wide character that
+// would straddle the limit is dropped rather than overflow it.
+func truncateName(name string, width int) string {
+	if NameWidth(name) <= width {
+		return name
+	}
+	if width <= 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	used := 0
+	graphemes := uniseg.NewGraphemes(name)
+	for graphemes.Next() {
+		w := graphemes.Width()
+		if used+w > width-1 { // Leave a column for the ellipsis
+			break
+		}
+		b.WriteString(graphemes.Str())
+		used += w
+	}
+	return b.String() + "…"
+}
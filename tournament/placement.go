@@ -0,0 +1,36 @@
+package tournament
+
+import "github.com/charmbracelet/lipgloss"
+
+// PlacedAt returns the screen cell where the top-left corner of sections[index]
+// is drawn when the screens lay out their views: sections joined vertically and
+// centred, then placed in the centre of a width×height terminal. Mouse clicks
+// are resolved against it.
+func PlacedAt(width, height int, sections []string, index int) (x, y int) {
+	blockWidth, blockHeight := 0, 0
+	for i, section := range sections {
+		blockWidth = max(blockWidth, lipgloss.Width(section))
+		if i < index {
+			y += lipgloss.Height(section)
+		}
+		blockHeight += lipgloss.Height(section)
+	}
+
+	// Mirrors lipgloss, which puts the odd cell of a centred gap on the left
+	// when joining and on the right when placing
+	x = centredGap(blockWidth-lipgloss.Width(sections[index]), true)
+	x += centredGap(width-blockWidth, false)
+	y += centredGap(height-blockHeight, false)
+	return x, y
+}
+
+// centredGap returns the space before something centred in gap cells.
+func centredGap(gap int, oddLeft bool) int {
+	if gap <= 0 {
+		return 0
+	}
+	if oddLeft {
+		return gap - (gap+1)/2
+	}
+	return (gap + 1) / 2
+}
@@ -0,0 +1,126 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"go-tournament/i18n"
+)
+
+// ErrBracketIncomplete is returned when placings are requested before the final is decided.
+var ErrBracketIncomplete = errors.New("bracket is not complete")
+
+// Placing is a player's finishing position in a completed bracket.
+// Players eliminated in the same round share a place range, e.g. 5th-8th,
+// unless a tiebreak separates them.
+type Placing struct {
+	Place     int     // Highest place in the player's group (1 is champion)
+	TiedTo    int     // Lowest place in the group; equals Place when untied
+	Player    *Player // The placed player
+	LostRound int     // Round the player was knocked out of the main bracket (-1 for the champion)
+}
+
+// IsTied reports whether the placing is shared with other players.
+func (p Placing) IsTied() bool {
+	return p.TiedTo > p.Place
+}
+
+// Label returns the place as an ordinal, with a range for tied places (e.g. "5th–8th").
+func (p Placing) Label() string {
+	if p.IsTied() {
+		return fmt.Sprintf("%s–%s", Ordinal(p.Place), Ordinal(p.TiedTo))
+	}
+	return Ordinal(p.Place)
+}
+
+// placingOptions holds optional ranking settings.
+type placingOptions struct {
+	seedTiebreak bool
+}
+
+// PlacingOption configures how tied places are resolved.
+type PlacingOption func(*placingOptions)
+
+// WithSeedTiebreak breaks ties between players eliminated in the same round by
+// seed, so the better-seeded player takes the higher place.
+func WithSeedTiebreak() PlacingOption {
+	return func(o *placingOptions) {
+		o.seedTiebreak = true
+	}
+}
+
+// Placings returns the final ranking of a completed bracket, champion first.
+// The runner-up is the final's loser; with a third-place match its winner and loser
+// take 3rd and 4th. Everyone else is grouped by the round they lost in, later
+// rounds ranking higher, and ordered by seed within each group.
+func (b *Bracket) Placings(opts ...PlacingOption) ([]Placing, error) {
+	if !b.IsComplete {
+		return nil, ErrBracketIncomplete
+	}
+
+	var options placingOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	final := b.FinalMatch()
+	placings := []Placing{{
+		Place:     1,
+		TiedTo:    1,
+		Player:    final.Winner,
+		LostRound: -1,
+	}}
+
+	// Each group is ranked below everyone placed before it
+	addGroup := func(matches []*Match) {
+		var group []Placing
+		for _, match := range matches {
+			if loser := match.Loser(); loser != nil {
+				group = append(group, Placing{
+					Player:    loser,
+					LostRound: match.Round,
+				})
+			}
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].Player.Seed < group[j].Player.Seed
+		})
+
+		first := len(placings) + 1
+		for i := range group {
+			if options.seedTiebreak {
+				group[i].Place = first + i
+				group[i].TiedTo = first + i
+			} else {
+				group[i].Place = first
+				group[i].TiedTo = first + len(group) - 1
+			}
+		}
+		placings = append(placings, group...)
+	}
+
+	addGroup([]*Match{final})
+
+	semifinalRound := b.TotalRounds - 2
+	lastRound := semifinalRound
+	if thirdPlace := b.ThirdPlaceMatch(); thirdPlace != nil {
+		// Both playoff players went out in the semifinals; the playoff splits them
+		placings = append(placings,
+			Placing{Place: 3, TiedTo: 3, Player: thirdPlace.Winner, LostRound: semifinalRound},
+			Placing{Place: 4, TiedTo: 4, Player: thirdPlace.Loser(), LostRound: semifinalRound},
+		)
+		lastRound--
+	}
+
+	for round := lastRound; round >= 0; round-- {
+		addGroup(b.MatchesInRound(round))
+	}
+
+	return placings, nil
+}
+
+// Ordinal returns n as a place in the current language, e.g. 1st, 2nd, 11th, 23rd.
+func Ordinal(n int) string {
+	return i18n.Ordinal(n)
+}
@@ -0,0 +1,12 @@
+package tournament
+
+// PlayerDirectory finds and registers players kept between tournaments, such
+// as a club's player registry, so setup can pick regulars instead of retyping them.
+type PlayerDirectory interface {
+	// SearchPlayers returns players whose name or alias contains query,
+	// ignoring case. An empty query lists everyone.
+	SearchPlayers(query string) ([]Player, error)
+
+	// RegisterPlayer adds a new player and returns them with RegistryID set.
+	RegisterPlayer(name string) (Player, error)
+}
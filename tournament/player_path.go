@@ -0,0 +1,133 @@
+package tournament
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PlayerPath is a player's way through the bracket: the matches they have
+// played so far and the route they would take by winning every match left.
+type PlayerPath struct {
+	Player *Player
+	Played []*Match    // Matches the player has been drawn into, in bracket order
+	Route  []RouteStep // Undecided matches from their current one to the last they could reach; empty once out
+}
+
+// RouteStep is a match on a player's route with everyone they could meet there.
+type RouteStep struct {
+	Match     *Match
+	Opponents []*Player // Players still in with a chance of reaching the match from the other side, by seed
+}
+
+// PathOf returns a player's path: the matches they have been drawn into,
+// including byes and the match they are playing or waiting for, and the
+// route onward through NextMatchID if they keep winning.
+func (b *Bracket) PathOf(playerID int) (PlayerPath, error) {
+	var path PlayerPath
+	for i := range b.Participants {
+		if b.Participants[i].ID == playerID {
+			path.Player = &b.Participants[i]
+		}
+	}
+	if path.Player == nil {
+		return path, fmt.Errorf("player %d: %w", playerID, ErrPlayerNotFound)
+	}
+
+	for i := range b.Matches {
+		match := &b.Matches[i]
+		if match.Player1 != nil && match.Player1.ID == playerID ||
+			match.Player2 != nil && match.Player2.ID == playerID {
+			path.Played = append(path.Played, match)
+		}
+	}
+
+	// Only a player waiting on a result has a route; winners have already
+	// moved on and losers are out, or playing for third place
+	current := path.Latest()
+	if current == nil || current.Winner != nil {
+		return path, nil
+	}
+	var from *Match
+	for match := current; match != nil; {
+		path.Route = append(path.Route, RouteStep{Match: match, Opponents: b.opponents(match, from, playerID)})
+		from = match
+		match, _ = b.MatchByID(match.NextMatchID)
+	}
+	return path, nil
+}
+
+// Latest returns the match the player is playing or waiting for, or their
+// last match once they are out. It returns nil for a player not yet drawn.
+func (p PlayerPath) Latest() *Match {
+	if len(p.Played) == 0 {
+		return nil
+	}
+	return p.Played[len(p.Played)-1]
+}
+
+// Contains reports whether the player has been drawn into a match.
+func (p PlayerPath) Contains(matchID int) bool {
+	for _, match := range p.Played {
+		if match.ID == matchID {
+			return true
+		}
+	}
+	return false
+}
+
+// Ahead reports whether a match is on the player's route but they have not
+// reached it yet.
+func (p PlayerPath) Ahead(matchID int) bool {
+	if p.Contains(matchID) {
+		return false
+	}
+	for _, step := range p.Route {
+		if step.Match.ID == matchID {
+			return true
+		}
+	}
+	return false
+}
+
+// opponents returns who a player could meet in a match on their route,
+// having come through from (nil when the player is already seated there):
+// everyone who could reach the match except those the player beats on the
+// way. They are ordered by seed.
+func (b *Bracket) opponents(match, from *Match, playerID int) []*Player {
+	ownSide := map[int]bool{playerID: true}
+	if from != nil {
+		for _, p := range b.contenders(from) {
+			ownSide[p.ID] = true
+		}
+	}
+
+	var players []*Player
+	for _, p := range b.contenders(match) {
+		if !ownSide[p.ID] {
+			players = append(players, p)
+		}
+	}
+	sort.SliceStable(players, func(i, j int) bool {
+		return players[i].Seed < players[j].Seed
+	})
+	return players
+}
+
+// contenders returns every player who could still play in a match: those
+// already seated, and anyone still in the undecided matches that feed it,
+// whether by winning or, for the third-place match, by losing.
+func (b *Bracket) contenders(match *Match) []*Player {
+	var players []*Player
+	for _, p := range []*Player{match.Player1, match.Player2} {
+		if p != nil {
+			players = append(players, p)
+		}
+	}
+	for i := range b.Matches {
+		feeder := &b.Matches[i]
+		if feeder.Winner == nil && (feeder.NextMatchID == match.ID || feeder.LoserNextMatchID == match.ID) {
+			players = append(players, b.contenders(feeder)...)
+		}
+	}
+	return players
+}
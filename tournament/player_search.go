@@ -0,0 +1,65 @@
+package tournament
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// FindPlayers returns the participants whose names fuzzily match query: the
+// query's letters appear in the name in order, ignoring case, so "kmj" finds
+// "Kim Min-jun". Closer matches come first, then higher seeds. An empty
+// query matches nobody.
+func (b *Bracket) FindPlayers(query string) []*Player {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+
+	type hit struct {
+		player *Player
+		score  int
+	}
+	var hits []hit
+	for i := range b.Participants {
+		if score, ok := fuzzyScore(query, strings.ToLower(b.Participants[i].Name)); ok {
+			hits = append(hits, hit{&b.Participants[i], score})
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		return hits[i].player.Seed < hits[j].player.Seed
+	})
+
+	players := make([]*Player, len(hits))
+	for i, h := range hits {
+		players[i] = h.player
+	}
+	return players
+}
+
+// fuzzyScore reports whether every rune of query appears in name in order,
+// and how well: runes that follow on from the previous match or start a
+// word score extra. Both must already be lower case.
+func fuzzyScore(query, name string) (int, bool) {
+	q := []rune(query)
+	n := []rune(name)
+	score, next, prev := 0, 0, -2
+	for i := 0; i < len(n) && next < len(q); i++ {
+		if n[i] != q[next] {
+			continue
+		}
+		score++
+		if i == prev+1 {
+			score += 2 // Consecutive, as in a typed prefix
+		}
+		if i == 0 || !unicode.IsLetter(n[i-1]) && !unicode.IsDigit(n[i-1]) {
+			score += 3 // Start of a word, such as a surname
+		}
+		prev = i
+		next++
+	}
+	return score, next == len(q)
+}
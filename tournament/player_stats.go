@@ -0,0 +1,205 @@
+package tournament
+
+import (
+	"fmt"
+	"time"
+
+	"go-tournament/i18n"
+)
+
+// PlayerKey identifies a player across tournaments. Registered players are
+// the same player wherever they appear; unregistered players are only known
+// within the tournament they were entered in.
+type PlayerKey struct {
+	RegistryID   int64
+	TournamentID int64 // Set only for unregistered players
+	PlayerID     int   // Set only for unregistered players
+}
+
+// KeyOf returns the key of a player entered in the given tournament.
+func KeyOf(p Player, tournamentID int64) PlayerKey {
+	if p.RegistryID != 0 {
+		return PlayerKey{RegistryID: p.RegistryID}
+	}
+	return PlayerKey{TournamentID: tournamentID, PlayerID: p.ID}
+}
+
+// MatchRecord is a decided match from one player's point of view. Byes are
+// not matches and never appear.
+type MatchRecord struct {
+	TournamentID int64
+	Tournament   string
+	Round        int // 0-indexed
+	TotalRounds  int
+	IsThirdPlace bool
+	Opponent     Player
+	OpponentKey  PlayerKey
+	Won          bool
+	Walkover     bool // Decided without play
+	At           time.Time
+}
+
+// RoundLabel returns the name of the round the match was played in.
+func (r MatchRecord) RoundLabel() string {
+	if r.IsThirdPlace {
+		return i18n.T("Third place")
+	}
+	return roundLabel(r.Round, r.TotalRounds)
+}
+
+// Appearance is a player's run through one tournament.
+type Appearance struct {
+	TournamentID int64
+	Tournament   string
+	Player       Player // The player as entered in this tournament
+	TotalRounds  int
+	Furthest     int  // Furthest main bracket round reached, 0-indexed
+	Champion     bool // Won the final
+	Matches      []MatchRecord
+}
+
+// Appearance returns the run of a player through the bracket so far, with
+// their decided matches in bracket order. tournamentID and name label the
+// records, since a bracket does not know which tournament it belongs to.
+func (b *Bracket) Appearance(playerID int, tournamentID int64, name string) (Appearance, error) {
+	var player *Player
+	for i := range b.Participants {
+		if b.Participants[i].ID == playerID {
+			player = &b.Participants[i]
+		}
+	}
+	if player == nil {
+		return Appearance{}, fmt.Errorf("player %d: %w", playerID, ErrPlayerNotFound)
+	}
+
+	a := Appearance{
+		TournamentID: tournamentID,
+		Tournament:   name,
+		Player:       *player,
+		TotalRounds:  b.TotalRounds,
+	}
+	for _, match := range b.Matches {
+		var opponent *Player
+		switch {
+		case match.Player1 != nil && match.Player1.ID == playerID:
+			opponent = match.Player2
+		case match.Player2 != nil && match.Player2.ID == playerID:
+			opponent = match.Player1
+		default:
+			continue
+		}
+
+		// Reaching the third-place match means having reached the semifinals
+		if !match.IsThirdPlace && match.Round > a.Furthest {
+			a.Furthest = match.Round
+		}
+		won := match.Winner != nil && match.Winner.ID == playerID
+		if won && !match.IsThirdPlace && match.Round == b.TotalRounds-1 {
+			a.Champion = true
+		}
+		if opponent == nil || !match.Status.IsDecided() || match.IsBye {
+			continue
+		}
+
+		a.Matches = append(a.Matches, MatchRecord{
+			TournamentID: tournamentID,
+			Tournament:   name,
+			Round:        match.Round,
+			TotalRounds:  b.TotalRounds,
+			IsThirdPlace: match.IsThirdPlace,
+			Opponent:     *opponent,
+			OpponentKey:  KeyOf(*opponent, tournamentID),
+			Won:          won,
+			Walkover:     match.Status == MatchWalkover,
+			At:           match.CompletedAt,
+		})
+	}
+	return a, nil
+}
+
+// FurthestLabel describes how far the player got, e.g. "Semifinals" or "Champion".
+func (a Appearance) FurthestLabel() string {
+	if a.Champion {
+		return i18n.T("Champion")
+	}
+	return roundLabel(a.Furthest, a.TotalRounds)
+}
+
+// roundsFromEnd ranks how far the player got regardless of bracket size:
+// 0 is the final and -1 is winning it.
+func (a Appearance) roundsFromEnd() int {
+	if a.Champion {
+		return -1
+	}
+	return a.TotalRounds - 1 - a.Furthest
+}
+
+// PlayerStats summarises a player's appearances.
+type PlayerStats struct {
+	Appearances []Appearance
+	Played      int         // Matches decided by play
+	Wins        int         // Wins by play
+	Losses      int         // Losses by play
+	Walkovers   int         // Matches decided without play, either way
+	Best        *Appearance // Furthest run; the most recent wins ties (nil without appearances)
+	Beaten      []Player    // Opponents beaten by play, each listed once, in the order first beaten
+	records     []MatchRecord
+}
+
+// NewPlayerStats summarises appearances, which should be given oldest first.
+func NewPlayerStats(appearances []Appearance) PlayerStats {
+	s := PlayerStats{Appearances: appearances}
+	beaten := make(map[PlayerKey]bool)
+	for i := range appearances {
+		a := &appearances[i]
+		if s.Best == nil || a.roundsFromEnd() <= s.Best.roundsFromEnd() {
+			s.Best = a
+		}
+
+		for _, r := range a.Matches {
+			s.records = append(s.records, r)
+			if r.Walkover {
+				s.Walkovers++
+				continue
+			}
+			s.Played++
+			if !r.Won {
+				s.Losses++
+				continue
+			}
+			s.Wins++
+			if !beaten[r.OpponentKey] {
+				beaten[r.OpponentKey] = true
+				s.Beaten = append(s.Beaten, r.Opponent)
+			}
+		}
+	}
+	return s
+}
+
+// HeadToHead is a player's record against one opponent.
+type HeadToHead struct {
+	Wins    int
+	Losses  int
+	Matches []MatchRecord // Every meeting, walkovers included, oldest first
+}
+
+// HeadToHead returns the player's record against the opponent with the given key.
+// Walkovers are listed but do not count as wins or losses.
+func (s PlayerStats) HeadToHead(opponent PlayerKey) HeadToHead {
+	var h HeadToHead
+	for _, r := range s.records {
+		if r.OpponentKey != opponent {
+			continue
+		}
+		h.Matches = append(h.Matches, r)
+		switch {
+		case r.Walkover:
+		case r.Won:
+			h.Wins++
+		default:
+			h.Losses++
+		}
+	}
+	return h
+}
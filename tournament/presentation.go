@@ -0,0 +1,436 @@
+package tournament
+
+import (
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go-tournament/i18n"
+	"go-tournament/keymap"
+)
+
+// DefaultPresentationInterval is how long each presentation slide is shown.
+const DefaultPresentationInterval = 10 * time.Second
+
+const (
+	presentationCardWidth  = 34 // Match card, border included
+	presentationCardHeight = 5  // Border, two players, status
+	presentationCardGap    = 2
+	presentationChromeRows = bigTextRows + 6 // Title, subtitle, slide dots and the blank lines between
+)
+
+// slideKind is what a presentation slide shows.
+type slideKind int
+
+const (
+	slideChampion slideKind = iota
+	slideUpNext
+	slideRound
+)
+
+// presentationSlide is one page of the presentation. A round with more
+// matches than fit on screen is split over several pages.
+type presentationSlide struct {
+	kind  slideKind
+	round int // 0-indexed, for round slides
+	page  int
+	pages int
+}
+
+// presentationTickMsg advances the slides. Ticks from before the last manual
+// step or pause, or from an earlier presentation, carry an old generation and
+// are dropped.
+type presentationTickMsg struct {
+	generation int64
+}
+
+// presentationGenerations numbers slide timers across every presentation, so
+// a tick left over from one that was closed cannot drive a new one.
+var presentationGenerations atomic.Int64
+
+// PresentationModel shows the tournament on a big screen: one round at a
+// time in large type, and the matches playing and up next, cycling on a
+// timer. It has no cursor or help text, and follows the store, so results
+// appear as soon as they are recorded anywhere.
+type PresentationModel struct {
+	store      *Store
+	keys       keymap.Keymap
+	interval   time.Duration
+	slide      int // Counts up without bound; taken modulo the current slides
+	paused     bool
+	generation int64
+	width      int
+	height     int
+}
+
+// NewPresentationModel returns a presentation of the tournament held by store.
+func NewPresentationModel(store *Store) PresentationModel {
+	return PresentationModel{
+		store:      store,
+		keys:       keymap.Default(),
+		interval:   DefaultPresentationInterval,
+		generation: presentationGenerations.Add(1),
+	}
+}
+
+// WithKeymap replaces the default key bindings.
+func (m PresentationModel) WithKeymap(keys keymap.Keymap) PresentationModel {
+	m.keys = keys
+	return m
+}
+
+// WithInterval sets how long each slide is shown.
+func (m PresentationModel) WithInterval(interval time.Duration) PresentationModel {
+	if interval > 0 {
+		m.interval = interval
+	}
+	return m
+}
+
+// Init starts the slide timer.
+func (m PresentationModel) Init() tea.Cmd {
+	return m.tick()
+}
+
+// tick schedules the next slide for the current generation.
+func (m PresentationModel) tick() tea.Cmd {
+	generation := m.generation
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return presentationTickMsg{generation: generation}
+	})
+}
+
+// HelpKeys returns the bindings for the help overlay.
+func (m PresentationModel) HelpKeys() []key.Binding {
+	return append(keymap.Bindings(m.keys.Presentation), m.keys.Global.Back)
+}
+
+func (m PresentationModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case presentationTickMsg:
+		if msg.generation != m.generation || m.paused {
+			return m, nil
+		}
+		m.slide++
+		return m, m.tick()
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Presentation.Next):
+			m.slide++
+		case key.Matches(msg, m.keys.Presentation.Prev):
+			m.slide--
+		case key.Matches(msg, m.keys.Presentation.Pause):
+			m.paused = !m.paused
+		default:
+			return m, nil
+		}
+		// Restart the timer so the slide stepped to gets its full time
+		m.generation = presentationGenerations.Add(1)
+		if m.paused {
+			return m, nil
+		}
+		return m, m.tick()
+	}
+	return m, nil
+}
+
+func (m PresentationModel) View() string {
+	var view string
+	m.store.View(func(t Tournament) {
+		if t.Bracket == nil {
+			view = m.renderTitle(i18n.T("WAITING"), i18n.T("Waiting for the draw"), "")
+			return
+		}
+		slides := m.slides(t.Bracket)
+		index := ((m.slide % len(slides)) + len(slides)) % len(slides)
+		view = m.renderSlide(t, slides[index], m.renderDots(index, len(slides)))
+	})
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		view,
+	)
+}
+
+// slides returns the slides for the bracket as it stands: the champion once
+// there is one, the matches playing and up next, then every round with a
+// known player, paged to fit the screen.
+func (m PresentationModel) slides(b *Bracket) []presentationSlide {
+	var slides []presentationSlide
+	if b.IsComplete {
+		slides = append(slides, presentationSlide{kind: slideChampion})
+	}
+	slides = append(slides, presentationSlide{kind: slideUpNext})
+
+	perPage := m.cardsPerPage()
+	for round := 0; round < b.TotalRounds; round++ {
+		matches := presentationMatches(b, round)
+		if len(matches) == 0 {
+			continue
+		}
+		pages := (len(matches) + perPage - 1) / perPage
+		for page := 0; page < pages; page++ {
+			slides = append(slides, presentationSlide{kind: slideRound, round: round, page: page, pages: pages})
+		}
+	}
+	return slides
+}
+
+// presentationMatches returns the matches of a round worth showing: byes
+// are left out, the third-place match joins the final, and a round nobody
+// has reached yet has none.
+func presentationMatches(b *Bracket, round int) []*Match {
+	var matches []*Match
+	known := false
+	candidates := b.MatchesInRound(round)
+	if round == b.TotalRounds-1 {
+		if thirdPlace := b.ThirdPlaceMatch(); thirdPlace != nil {
+			candidates = append(candidates, thirdPlace)
+		}
+	}
+	for _, match := range candidates {
+		if match.IsBye {
+			continue
+		}
+		matches = append(matches, match)
+		known = known || match.Player1 != nil || match.Player2 != nil
+	}
+	if !known {
+		return nil
+	}
+	return matches
+}
+
+// cardGrid returns how many match cards fit across and down the screen.
+func (m PresentationModel) cardGrid() (columns, rows int) {
+	columns = max((m.width+presentationCardGap)/(presentationCardWidth+presentationCardGap), 1)
+	rows = max((m.height-presentationChromeRows)/presentationCardHeight, 1)
+	return columns, rows
+}
+
+// cardsPerPage returns how many match cards fit on one slide.
+func (m PresentationModel) cardsPerPage() int {
+	columns, rows := m.cardGrid()
+	return columns * rows
+}
+
+// renderSlide renders one slide with the slide dots beneath it.
+func (m PresentationModel) renderSlide(t Tournament, slide presentationSlide, dots string) string {
+	b := t.Bracket
+	switch slide.kind {
+	case slideChampion:
+		placings, err := b.Placings()
+		if err != nil || len(placings) == 0 {
+			return m.renderTitle(i18n.T("CHAMPION"), "", dots)
+		}
+		name := placings[0].Player.Name
+		if big, ok := bigText(name, m.width-seChampionStyle.GetHorizontalFrameSize()); ok {
+			name = big
+		}
+		return lipgloss.JoinVertical(lipgloss.Center,
+			m.renderTitle(i18n.T("CHAMPION"), "", ""), "", seChampionStyle.Render(name), "", dots)
+
+	case slideUpNext:
+		return lipgloss.JoinVertical(lipgloss.Center,
+			m.renderTitle(i18n.T("UP NEXT"), "", ""), "", m.renderUpNext(t), "", dots)
+
+	default:
+		matches := presentationMatches(b, slide.round)
+		subtitle := i18n.T("%d of %d decided", decidedCount(matches), len(matches))
+		if slide.round == activeRound(b) {
+			subtitle = i18n.T("Now playing • %s", subtitle)
+		}
+		perPage := m.cardsPerPage()
+		page := matches[slide.page*perPage : min((slide.page+1)*perPage, len(matches))]
+		if slide.pages > 1 {
+			subtitle += i18n.T(" • page %d of %d", slide.page+1, slide.pages)
+		}
+		return lipgloss.JoinVertical(lipgloss.Center,
+			m.renderTitle(roundLabel(slide.round, b.TotalRounds), subtitle, ""), "", m.renderCards(b, page), "", dots)
+	}
+}
+
+// renderTitle renders a slide title in block letters when it fits, with an
+// optional subtitle and the slide dots.
+func (m PresentationModel) renderTitle(title, subtitle, dots string) string {
+	big, ok := bigText(title, m.width)
+	if !ok {
+		big = title
+	}
+	sections := []string{bracketRoundHeaderStyle.Render(big)}
+	if subtitle != "" {
+		sections = append(sections, "", sePlacingStyle.Render(subtitle))
+	}
+	if dots != "" {
+		sections = append(sections, "", dots)
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, sections...)
+}
+
+// renderCards lays out match cards in rows that fill the screen width.
+func (m PresentationModel) renderCards(b *Bracket, matches []*Match) string {
+	if len(matches) == 0 {
+		return ""
+	}
+	columns, _ := m.cardGrid()
+	// Spread the cards evenly over the rows they need rather than leaving
+	// one short row at the bottom
+	rowCount := (len(matches) + columns - 1) / columns
+	columns = (len(matches) + rowCount - 1) / rowCount
+	var rows []string
+	for start := 0; start < len(matches); start += columns {
+		var cards []string
+		for _, match := range matches[start:min(start+columns, len(matches))] {
+			if len(cards) > 0 {
+				cards = append(cards, strings.Repeat(" ", presentationCardGap))
+			}
+			cards = append(cards, m.renderCard(b, match))
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cards...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, rows...)
+}
+
+// renderCard renders a match in large type: both players, the winner
+// highlighted, and its status or court beneath.
+func (m PresentationModel) renderCard(b *Bracket, match *Match) string {
+	width := presentationCardWidth - 4 // Border and padding
+	name := func(player *Player) string {
+		if player == nil {
+			return bracketPlaceholderStyle.Render(i18n.T("TBD"))
+		}
+		text := truncateName(player.Name, width)
+		switch {
+		case match.Winner == nil:
+			return sePlacingStyle.Bold(true).Render(text)
+		case match.Winner.ID == player.ID:
+			return bracketWinnerStyle.Render(text)
+		default:
+			return bracketLoserStyle.Render(text)
+		}
+	}
+
+	status := i18n.T(match.Status.String())
+	if match.IsThirdPlace {
+		status = matchRoundName(b, match) + " • " + status
+	}
+	card := lipgloss.JoinVertical(lipgloss.Left,
+		name(match.Player1),
+		name(match.Player2),
+		bracketPlaceholderStyle.Render(status),
+	)
+	return bracketMatchStyle.
+		BorderForeground(bracketStatusColors[match.Status]).
+		Width(presentationCardWidth - 2).
+		Render(card)
+}
+
+// presentationUpNextRows is how many matches the up next slide lists.
+const presentationUpNextRows = 8
+
+// renderUpNext lists the matches being played, by court when courts are
+// managed, and the matches that can be called next.
+func (m PresentationModel) renderUpNext(t Tournament) string {
+	type entry struct {
+		label string
+		match *Match
+	}
+	b := t.Bracket
+	var playing, next []entry
+	if t.Courts != nil {
+		for _, court := range t.Courts.Courts() {
+			if match, err := b.MatchByID(court.MatchID); err == nil && !court.IsFree() {
+				playing = append(playing, entry{court.Name, match})
+			}
+		}
+		for _, match := range t.Courts.Queue() {
+			next = append(next, entry{matchRoundName(b, match), match})
+		}
+	} else {
+		for _, match := range b.MatchesWithStatus(MatchInProgress) {
+			playing = append(playing, entry{matchRoundName(b, match), match})
+		}
+		for _, match := range b.MatchesWithStatus(MatchReady) {
+			next = append(next, entry{matchRoundName(b, match), match})
+		}
+	}
+	playing = playing[:min(len(playing), presentationUpNextRows)]
+	next = next[:min(len(next), presentationUpNextRows)]
+
+	// Labels share one column so the matchups line up
+	width := 0
+	for _, e := range append(playing, next...) {
+		width = max(width, lipgloss.Width(e.label))
+	}
+	lines := func(entries []entry) []string {
+		var lines []string
+		for _, e := range entries {
+			label := bracketPlaceholderStyle.Width(width).Render(e.label)
+			lines = append(lines, label+"  "+sePlacingStyle.Bold(true).Render(matchupText(e.match, 0)))
+		}
+		return lines
+	}
+
+	var sections []string
+	if len(playing) > 0 {
+		sections = append(sections, sePodiumStyle.Render(i18n.T("Now Playing")), "")
+		sections = append(sections, lines(playing)...)
+		sections = append(sections, "")
+	}
+	sections = append(sections, sePodiumStyle.Render(i18n.T("Ready to Play")), "")
+	if len(next) == 0 {
+		sections = append(sections, bracketPlaceholderStyle.Render(i18n.T("Waiting for results")))
+	}
+	sections = append(sections, lines(next)...)
+	return seInfoBoxStyle.Align(lipgloss.Left).Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// renderDots marks the current slide among all of them, and says when the
+// timer is paused.
+func (m PresentationModel) renderDots(index, count int) string {
+	dots := make([]string, count)
+	for i := range dots {
+		dots[i] = "○"
+		if i == index {
+			dots[i] = "●"
+		}
+	}
+	text := strings.Join(dots, " ")
+	if m.paused {
+		text += "   " + i18n.T("paused")
+	}
+	return bracketPlaceholderStyle.Render(text)
+}
+
+// activeRound returns the earliest round with an undecided match, or -1
+// when every match is decided.
+func activeRound(b *Bracket) int {
+	for round := 0; round < b.TotalRounds; round++ {
+		for _, match := range presentationMatches(b, round) {
+			if !match.Status.IsDecided() {
+				return round
+			}
+		}
+	}
+	return -1
+}
+
+// decidedCount returns how many of the matches are decided.
+func decidedCount(matches []*Match) int {
+	count := 0
+	for _, match := range matches {
+		if match.Status.IsDecided() {
+			count++
+		}
+	}
+	return count
+}
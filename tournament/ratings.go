@@ -0,0 +1,195 @@
+package tournament
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultRating is the Elo rating players start on before their first rated match.
+	DefaultRating = 1500.0
+
+	// DefaultKFactor is the most a single match can move a rating, by half on average.
+	DefaultKFactor = 32.0
+)
+
+// RatedResult is a match that counts towards ratings: both players are in the
+// player registry and the match was decided by play. Byes and walkovers say
+// nothing about form, so they are never rated.
+type RatedResult struct {
+	TournamentID int64
+	Tournament   string // Tournament name, for history
+	MatchID      int
+	WinnerID     int64 // Registry ID of the winner
+	LoserID      int64 // Registry ID of the loser
+	At           time.Time
+}
+
+// RatedResultOf returns the rated result of a match, or false if the match
+// does not count: not completed by play, or a player is not registered.
+func RatedResultOf(match Match) (RatedResult, bool) {
+	if match.Status != MatchCompleted || match.IsBye || match.Winner == nil ||
+		match.Player1 == nil || match.Player2 == nil {
+		return RatedResult{}, false
+	}
+
+	loser := match.Player1
+	if match.Winner.ID == loser.ID {
+		loser = match.Player2
+	}
+	if match.Winner.RegistryID == 0 || loser.RegistryID == 0 {
+		return RatedResult{}, false
+	}
+	return RatedResult{
+		MatchID:  match.ID,
+		WinnerID: match.Winner.RegistryID,
+		LoserID:  loser.RegistryID,
+		At:       match.CompletedAt,
+	}, true
+}
+
+// RatingChange is one entry of a player's rating history.
+type RatingChange struct {
+	Result     RatedResult
+	OpponentID int64   // Registry ID of the opponent
+	Won        bool    // True if this player won the match
+	Rating     float64 // Rating after the match
+	Delta      float64 // Change caused by the match
+}
+
+// PlayerRating is a registered player's current rating and how they got there.
+type PlayerRating struct {
+	RegistryID int64
+	Rating     float64
+	Wins       int
+	Losses     int
+	History    []RatingChange // Oldest first
+}
+
+// Played returns the number of rated matches.
+func (p PlayerRating) Played() int {
+	return p.Wins + p.Losses
+}
+
+// ratingOptions holds optional rating settings.
+type ratingOptions struct {
+	initial float64
+	kFactor float64
+}
+
+// RatingOption configures how ratings are calculated.
+type RatingOption func(*ratingOptions)
+
+// WithInitialRating sets the rating new players start on.
+func WithInitialRating(rating float64) RatingOption {
+	return func(o *ratingOptions) {
+		o.initial = rating
+	}
+}
+
+// WithKFactor sets how strongly a single result moves ratings.
+func WithKFactor(k float64) RatingOption {
+	return func(o *ratingOptions) {
+		o.kFactor = k
+	}
+}
+
+// Ratings tracks Elo ratings of registered players. Results must be applied
+// in the order they were played, since each one depends on the ratings before it.
+type Ratings struct {
+	options ratingOptions
+	players map[int64]*PlayerRating
+}
+
+// NewRatings returns ratings with every player on the initial rating.
+func NewRatings(opts ...RatingOption) *Ratings {
+	options := ratingOptions{initial: DefaultRating, kFactor: DefaultKFactor}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &Ratings{options: options, players: make(map[int64]*PlayerRating)}
+}
+
+// ExpectedScore returns the chance, by Elo, that a player rated a beats one rated b.
+func ExpectedScore(a, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/400))
+}
+
+// Apply updates both players' ratings for a result and records it in their history.
+func (r *Ratings) Apply(result RatedResult) {
+	winner, loser := r.player(result.WinnerID), r.player(result.LoserID)
+
+	// The winner gains exactly what the loser drops
+	delta := r.options.kFactor * (1 - ExpectedScore(winner.Rating, loser.Rating))
+	winner.Rating += delta
+	loser.Rating -= delta
+	winner.Wins++
+	loser.Losses++
+
+	winner.History = append(winner.History, RatingChange{
+		Result: result, OpponentID: loser.RegistryID, Won: true, Rating: winner.Rating, Delta: delta,
+	})
+	loser.History = append(loser.History, RatingChange{
+		Result: result, OpponentID: winner.RegistryID, Rating: loser.Rating, Delta: -delta,
+	})
+}
+
+// Rating returns a player's current rating; unrated and unregistered players
+// are on the initial rating.
+func (r *Ratings) Rating(registryID int64) float64 {
+	if p, ok := r.players[registryID]; ok {
+		return p.Rating
+	}
+	return r.options.initial
+}
+
+// Player returns a player's rating and history, or false if they have no rated matches.
+func (r *Ratings) Player(registryID int64) (PlayerRating, bool) {
+	p, ok := r.players[registryID]
+	if !ok {
+		return PlayerRating{}, false
+	}
+	return *p, true
+}
+
+// Leaderboard returns every rated player, highest rating first.
+func (r *Ratings) Leaderboard() []PlayerRating {
+	board := make([]PlayerRating, 0, len(r.players))
+	for _, p := range r.players {
+		board = append(board, *p)
+	}
+	sort.Slice(board, func(i, j int) bool {
+		if board[i].Rating != board[j].Rating {
+			return board[i].Rating > board[j].Rating
+		}
+		return board[i].RegistryID < board[j].RegistryID
+	})
+	return board
+}
+
+func (r *Ratings) player(registryID int64) *PlayerRating {
+	p, ok := r.players[registryID]
+	if !ok {
+		p = &PlayerRating{RegistryID: registryID, Rating: r.options.initial}
+		r.players[registryID] = p
+	}
+	return p
+}
+
+// RatingSource provides current ratings, such as ratings replayed from a
+// club's saved tournaments.
+type RatingSource interface {
+	Ratings() (*Ratings, error)
+}
+
+// SeedByRating returns players ordered for seeding, highest rating first, so
+// NewBracketWithPlayers keeps the strongest players apart. Unrated players
+// count as the initial rating; ties keep the order given.
+func SeedByRating(players []Player, ratings *Ratings) []Player {
+	seeded := copyParticipants(players)
+	sort.SliceStable(seeded, func(i, j int) bool {
+		return ratings.Rating(seeded[i].RegistryID) > ratings.Rating(seeded[j].RegistryID)
+	})
+	return seeded
+}
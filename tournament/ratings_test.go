@@ -0,0 +1,69 @@
+package tournament
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRatingsApplyElo(t *testing.T) {
+	ratings := NewRatings()
+
+	// Evenly matched players swing by half of K
+	ratings.Apply(RatedResult{WinnerID: 1, LoserID: 2, At: time.Now()})
+	if got := ratings.Rating(1); got != DefaultRating+DefaultKFactor/2 {
+		t.Errorf("winner rated %.2f, want %.2f", got, DefaultRating+DefaultKFactor/2)
+	}
+	if got := ratings.Rating(2); got != DefaultRating-DefaultKFactor/2 {
+		t.Errorf("loser rated %.2f, want %.2f", got, DefaultRating-DefaultKFactor/2)
+	}
+
+	// An upset moves ratings further than an expected win
+	ratings.Apply(RatedResult{WinnerID: 2, LoserID: 1, At: time.Now()})
+	want := DefaultKFactor * (1 - ExpectedScore(DefaultRating-16, DefaultRating+16))
+	player, _ := ratings.Player(2)
+	if delta := player.History[1].Delta; math.Abs(delta-want) > 1e-9 {
+		t.Errorf("upset moved rating by %.4f, want %.4f", delta, want)
+	}
+	if delta := player.History[1].Delta; delta <= DefaultKFactor/2 {
+		t.Errorf("upset moved rating by %.2f, no more than an even match", delta)
+	}
+	if sum := ratings.Rating(1) + ratings.Rating(2); math.Abs(sum-2*DefaultRating) > 1e-9 {
+		t.Errorf("ratings sum to %.4f, want %.4f", sum, 2*DefaultRating)
+	}
+	if player.Wins != 1 || player.Losses != 1 {
+		t.Errorf("record %d-%d, want 1-1", player.Wins, player.Losses)
+	}
+	if got := ratings.Rating(3); got != DefaultRating {
+		t.Errorf("unrated player rated %.2f, want %.2f", got, DefaultRating)
+	}
+}
+
+func TestRatedResultOfSkipsUnplayedMatches(t *testing.T) {
+	a := &Player{ID: 0, RegistryID: 10}
+	b := &Player{ID: 1, RegistryID: 20}
+	guest := &Player{ID: 2}
+
+	tests := []struct {
+		name  string
+		match Match
+		rated bool
+	}{
+		{"played", Match{Status: MatchCompleted, Player1: a, Player2: b, Winner: a}, true},
+		{"walkover", Match{Status: MatchWalkover, Player1: a, Player2: b, Winner: a}, false},
+		{"bye", Match{Status: MatchBye, IsBye: true, Player1: a, Winner: a}, false},
+		{"unregistered opponent", Match{Status: MatchCompleted, Player1: a, Player2: guest, Winner: a}, false},
+		{"undecided", Match{Status: MatchInProgress, Player1: a, Player2: b}, false},
+	}
+	for _, tt := range tests {
+		result, ok := RatedResultOf(tt.match)
+		if ok != tt.rated {
+			t.Errorf("%s: rated %v, want %v", tt.name, ok, tt.rated)
+			continue
+		}
+		if ok && (result.WinnerID != a.RegistryID || result.LoserID != b.RegistryID) {
+			t.Errorf("%s: rated %d over %d, want %d over %d", tt.name,
+				result.WinnerID, result.LoserID, a.RegistryID, b.RegistryID)
+		}
+	}
+}
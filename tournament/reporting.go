@@ -0,0 +1,345 @@
+package tournament
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrReportNotFound is returned when a report ID is not in the queue.
+	ErrReportNotFound = errors.New("report not found")
+
+	// ErrStaleReport is returned when a report's reporter or winner is no
+	// longer playing in its match, e.g. after the draw changed.
+	ErrStaleReport = errors.New("report no longer fits its match")
+)
+
+// ResultReport is a result submitted remotely by one of the match's players.
+// Reports wait in a ReportQueue until the organizer approves or rejects them.
+type ResultReport struct {
+	ID         int       // Unique identifier within the queue
+	MatchID    int       // Match being reported
+	ReporterID int       // Player who submitted the report
+	WinnerID   int       // Player the reporter says won
+	ReceivedAt time.Time // When the report arrived
+	Conflict   bool      // True if another report for the match names a different winner
+}
+
+// ReportQueue holds pending result reports. It is safe for concurrent use:
+// HTTP handlers submit while the TUI reviews.
+type ReportQueue struct {
+	mu       sync.Mutex
+	reports  []ResultReport
+	nextID   int
+	onChange func()
+}
+
+// NewReportQueue creates an empty queue.
+func NewReportQueue() *ReportQueue {
+	return &ReportQueue{}
+}
+
+// OnChange registers a callback run after every change to the queue, e.g. to
+// wake the TUI. It is called without the queue's lock held.
+func (q *ReportQueue) OnChange(fn func()) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.onChange = fn
+}
+
+// Submit adds a report. A player reporting the same match again replaces their
+// earlier report. Reports from both players naming different winners are flagged.
+func (q *ReportQueue) Submit(matchID, reporterID, winnerID int) ResultReport {
+	q.mu.Lock()
+
+	kept := q.reports[:0]
+	for _, r := range q.reports {
+		if r.MatchID != matchID || r.ReporterID != reporterID {
+			kept = append(kept, r)
+		}
+	}
+	q.reports = kept
+
+	report := ResultReport{
+		ID:         q.nextID,
+		MatchID:    matchID,
+		ReporterID: reporterID,
+		WinnerID:   winnerID,
+		ReceivedAt: time.Now(),
+	}
+	q.nextID++
+	q.reports = append(q.reports, report)
+	q.flagConflicts(matchID)
+
+	for _, r := range q.reports {
+		if r.ID == report.ID {
+			report = r
+		}
+	}
+	onChange := q.onChange
+	q.mu.Unlock()
+
+	if onChange != nil {
+		onChange()
+	}
+	return report
+}
+
+// Pending returns the queued reports, oldest first.
+func (q *ReportQueue) Pending() []ResultReport {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	reports := make([]ResultReport, len(q.reports))
+	copy(reports, q.reports)
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].ReceivedAt.Before(reports[j].ReceivedAt)
+	})
+	return reports
+}
+
+// Get returns a pending report by ID.
+func (q *ReportQueue) Get(reportID int) (ResultReport, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, r := range q.reports {
+		if r.ID == reportID {
+			return r, nil
+		}
+	}
+	return ResultReport{}, fmt.Errorf("report %d: %w", reportID, ErrReportNotFound)
+}
+
+// Reject removes a single report and re-checks the remaining reports for its match.
+func (q *ReportQueue) Reject(reportID int) error {
+	q.mu.Lock()
+
+	matchID := -1
+	kept := q.reports[:0]
+	for _, r := range q.reports {
+		if r.ID == reportID {
+			matchID = r.MatchID
+			continue
+		}
+		kept = append(kept, r)
+	}
+	q.reports = kept
+	if matchID == -1 {
+		q.mu.Unlock()
+		return fmt.Errorf("report %d: %w", reportID, ErrReportNotFound)
+	}
+	q.flagConflicts(matchID)
+	onChange := q.onChange
+	q.mu.Unlock()
+
+	if onChange != nil {
+		onChange()
+	}
+	return nil
+}
+
+// Clear drops every report, e.g. when a new draw reuses their match IDs.
+func (q *ReportQueue) Clear() {
+	q.mu.Lock()
+	changed := len(q.reports) > 0
+	q.reports = nil
+	onChange := q.onChange
+	q.mu.Unlock()
+
+	if changed && onChange != nil {
+		onChange()
+	}
+}
+
+// ClearMatch drops all reports for a match, e.g. once its result is entered directly.
+func (q *ReportQueue) ClearMatch(matchID int) {
+	q.mu.Lock()
+
+	kept := q.reports[:0]
+	for _, r := range q.reports {
+		if r.MatchID != matchID {
+			kept = append(kept, r)
+		}
+	}
+	changed := len(kept) != len(q.reports)
+	q.reports = kept
+	onChange := q.onChange
+	q.mu.Unlock()
+
+	if changed && onChange != nil {
+		onChange()
+	}
+}
+
+// CheckReport returns ErrStaleReport unless the report's reporter and winner
+// are both playing in its match as the bracket stands now.
+func (b *Bracket) CheckReport(report ResultReport) error {
+	match, err := b.MatchByID(report.MatchID)
+	if err != nil {
+		return fmt.Errorf("report %d: %w", report.ID, err)
+	}
+	if !matchHasPlayer(match, report.ReporterID) || !matchHasPlayer(match, report.WinnerID) {
+		return fmt.Errorf("report %d for match %d: %w", report.ID, report.MatchID, ErrStaleReport)
+	}
+	return nil
+}
+
+// flagConflicts marks reports for a match as conflicting when they disagree on
+// the winner. The caller must hold q.mu.
+func (q *ReportQueue) flagConflicts(matchID int) {
+	winners := make(map[int]bool)
+	for _, r := range q.reports {
+		if r.MatchID == matchID {
+			winners[r.WinnerID] = true
+		}
+	}
+	for i := range q.reports {
+		if q.reports[i].MatchID == matchID {
+			q.reports[i].Conflict = len(winners) > 1
+		}
+	}
+}
+
+// PlayerTokens maps secret reporting tokens to player IDs. It is safe for concurrent use.
+type PlayerTokens struct {
+	mu       sync.RWMutex
+	byToken  map[string]int
+	byPlayer map[int]string
+}
+
+// NewPlayerTokens creates an empty token set.
+func NewPlayerTokens() *PlayerTokens {
+	return &PlayerTokens{
+		byToken:  make(map[string]int),
+		byPlayer: make(map[int]string),
+	}
+}
+
+// Ensure issues a token to every player that does not have one yet.
+// Existing tokens are kept so players can keep using them after a redraw.
+func (t *PlayerTokens) Ensure(players []Player) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, p := range players {
+		if _, ok := t.byPlayer[p.ID]; ok {
+			continue
+		}
+		token, err := newToken()
+		if err != nil {
+			return err
+		}
+		t.byToken[token] = p.ID
+		t.byPlayer[p.ID] = token
+	}
+	return nil
+}
+
+// Reset revokes every token and issues fresh ones to players.
+func (t *PlayerTokens) Reset(players []Player) error {
+	t.mu.Lock()
+	t.byToken = make(map[string]int)
+	t.byPlayer = make(map[int]string)
+	t.mu.Unlock()
+	return t.Ensure(players)
+}
+
+// Sync issues tokens to players without one and revokes the tokens of anyone
+// no longer among players. A withdrawn player's ID can be given to the next
+// late entry, who must not inherit their token.
+func (t *PlayerTokens) Sync(players []Player) error {
+	playing := make(map[int]bool, len(players))
+	for _, p := range players {
+		playing[p.ID] = true
+	}
+
+	t.mu.Lock()
+	for playerID, token := range t.byPlayer {
+		if !playing[playerID] {
+			delete(t.byPlayer, playerID)
+			delete(t.byToken, token)
+		}
+	}
+	t.mu.Unlock()
+	return t.Ensure(players)
+}
+
+// Revoke withdraws a player's token.
+func (t *PlayerTokens) Revoke(playerID int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if token, ok := t.byPlayer[playerID]; ok {
+		delete(t.byPlayer, playerID)
+		delete(t.byToken, token)
+	}
+}
+
+// FollowReporting ties reporting to the store's current bracket. Match and
+// player IDs restart in every bracket, so when a new one is created the
+// queue is emptied and players get new tokens; otherwise a token or report
+// from the last tournament would apply to whoever has the same ID now.
+// Redraws and late changes to the field renumber the matches, so they empty
+// the queue too, and players joining or leaving gain or lose their tokens.
+// Call the returned function to stop.
+func FollowReporting(store *Store, queue *ReportQueue, tokens *PlayerTokens) (stop func()) {
+	return store.Subscribe(func(event Event) {
+		switch event.Kind {
+		case EventBracketCreated, EventBracketRedrawn, EventParticipantAdded, EventParticipantRemoved:
+		default:
+			return
+		}
+
+		queue.Clear()
+		var players []Player
+		store.View(func(t Tournament) {
+			if t.Bracket != nil {
+				players = copyParticipants(t.Bracket.Participants)
+			}
+		})
+		switch event.Kind {
+		case EventBracketCreated:
+			tokens.Reset(players)
+		case EventParticipantRemoved:
+			// Events arrive after the whole change, by when a late entry
+			// may already have been given the withdrawn player's ID
+			tokens.Revoke(event.PlayerID)
+			tokens.Sync(players)
+		default:
+			tokens.Sync(players)
+		}
+	})
+}
+
+// PlayerID returns the player a token belongs to.
+func (t *PlayerTokens) PlayerID(token string) (int, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	id, ok := t.byToken[token]
+	return id, ok
+}
+
+// Token returns the token issued to a player.
+func (t *PlayerTokens) Token(playerID int) (string, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	token, ok := t.byPlayer[playerID]
+	return token, ok
+}
+
+// newToken returns a random 64-bit token encoded as hex.
+func newToken() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
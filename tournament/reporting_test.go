@@ -0,0 +1,113 @@
+package tournament
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewBracketRevokesTokensAndReports(t *testing.T) {
+	store := NewStore()
+	queue := NewReportQueue()
+	tokens := NewPlayerTokens()
+	FollowReporting(store, queue, tokens)
+
+	create := func() {
+		bracket, err := NewBracketWithPlayers([]Player{{Name: "A"}, {Name: "B"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		store.Update(func(tx *Txn) error {
+			tx.Create(Tournament{Bracket: bracket})
+			return nil
+		})
+	}
+
+	create()
+	oldToken, ok := tokens.Token(0)
+	if !ok {
+		t.Fatal("no token issued for player 0")
+	}
+	report := queue.Submit(0, 0, 0)
+
+	create()
+	if _, ok := tokens.PlayerID(oldToken); ok {
+		t.Error("token from the previous bracket still accepted")
+	}
+	if _, ok := tokens.Token(0); !ok {
+		t.Error("no token issued for player 0 of the new bracket")
+	}
+	if _, err := queue.Get(report.ID); !errors.Is(err, ErrReportNotFound) {
+		t.Errorf("report from the previous bracket: got %v, want ErrReportNotFound", err)
+	}
+}
+
+func TestLateChangesClearReportsAndSyncTokens(t *testing.T) {
+	bracket, err := NewBracketWithPlayers([]Player{{Name: "A"}, {Name: "B"}, {Name: "C"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := NewStore()
+	queue := NewReportQueue()
+	tokens := NewPlayerTokens()
+	FollowReporting(store, queue, tokens)
+	store.Update(func(tx *Txn) error {
+		tx.Create(Tournament{Bracket: bracket})
+		return nil
+	})
+
+	withdrawn, ok := tokens.Token(2)
+	if !ok {
+		t.Fatal("no token issued for player 2")
+	}
+	report := queue.Submit(0, 0, 0)
+
+	// The late entry takes the withdrawn player's ID
+	store.Update(func(tx *Txn) error {
+		if err := tx.RemoveParticipant(2); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tx.AddParticipant("D"); err != nil {
+			t.Fatal(err)
+		}
+		return nil
+	})
+
+	if _, err := queue.Get(report.ID); !errors.Is(err, ErrReportNotFound) {
+		t.Errorf("report from before the redraw: got %v, want ErrReportNotFound", err)
+	}
+	if id, ok := tokens.PlayerID(withdrawn); ok {
+		t.Errorf("withdrawn player's token still accepted, for player %d", id)
+	}
+	if _, ok := tokens.Token(2); !ok {
+		t.Error("no token issued for the late entry")
+	}
+}
+
+func TestCheckReport(t *testing.T) {
+	bracket, err := NewBracketWithPlayers([]Player{{Name: "A"}, {Name: "B"}, {Name: "C"}, {Name: "D"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	match := bracket.MatchesInRound(0)[0]
+	p1, p2 := match.Player1.ID, match.Player2.ID
+	other := bracket.MatchesInRound(0)[1].Player1.ID
+
+	tests := []struct {
+		name   string
+		report ResultReport
+		want   error
+	}{
+		{"reporter wins", ResultReport{MatchID: match.ID, ReporterID: p1, WinnerID: p1}, nil},
+		{"reporter concedes", ResultReport{MatchID: match.ID, ReporterID: p1, WinnerID: p2}, nil},
+		{"reporter not playing", ResultReport{MatchID: match.ID, ReporterID: other, WinnerID: p1}, ErrStaleReport},
+		{"winner not playing", ResultReport{MatchID: match.ID, ReporterID: p1, WinnerID: other}, ErrStaleReport},
+		{"no such match", ResultReport{MatchID: 99, ReporterID: p1, WinnerID: p1}, ErrMatchNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := bracket.CheckReport(tt.report); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"go-tournament/i18n"
+)
+
+// ErrInvalidSchedule is returned when a schedule cannot be built from its configuration.
+var ErrInvalidSchedule = errors.New("invalid schedule configuration")
+
+// ScheduleConfig describes the event the schedule is built for.
+type ScheduleConfig struct {
+	Start         time.Time     // When the first matches begin
+	MatchDuration time.Duration // Average length of one match
+	Courts        int           // Number of courts or tables played on at once
+	MinRest       time.Duration // Minimum break for a player between matches
+	Now           time.Time     // Current time when rescheduling mid-event (zero before play)
+}
+
+// ScheduledMatch is the estimated slot for one match.
+type ScheduledMatch struct {
+	MatchID int       // Match the slot is for
+	Court   int       // Court index (0-indexed)
+	Start   time.Time // Estimated start time
+	End     time.Time // Estimated end time
+}
+
+// Schedule holds estimated start times for every match that has to be played.
+// Byes are not scheduled.
+type Schedule struct {
+	Config ScheduleConfig
+	Slots  []ScheduledMatch // Ordered by start time, then court
+
+	byMatch map[int]int // Match ID to index in Slots
+}
+
+// NewSchedule estimates a start time and court for every non-bye match.
+// A match never starts before its feeder matches (those whose NextMatchID or
+// LoserNextMatchID point to it) have finished and their players have rested,
+// and each match takes the court that frees up first.
+//
+// Recorded progress replaces estimates: decided matches end when their result was
+// entered, started matches begin when they were started, and with Now set no
+// pending match is estimated to start in the past.
+func NewSchedule(bracket *Bracket, config ScheduleConfig) (*Schedule, error) {
+	if config.Courts < 1 {
+		return nil, fmt.Errorf("%d courts: %w", config.Courts, ErrInvalidSchedule)
+	}
+	if config.MatchDuration <= 0 {
+		return nil, fmt.Errorf("match duration %s: %w", config.MatchDuration, ErrInvalidSchedule)
+	}
+
+	schedule := &Schedule{
+		Config:  config,
+		byMatch: make(map[int]int),
+	}
+	ends := make(map[int]time.Time)
+	courtFree := make([]time.Time, config.Courts)
+	for i := range courtFree {
+		courtFree[i] = config.Start
+	}
+
+	for _, match := range scheduleOrder(bracket) {
+		// Wait for feeders to finish and their players to rest
+		earliest := config.Start
+		if config.Now.After(earliest) && !match.Status.IsDecided() && match.StartedAt.IsZero() {
+			earliest = config.Now
+		}
+		for _, feeder := range feederMatches(bracket, match.ID) {
+			if end, ok := ends[feeder.ID]; ok && end.Add(config.MinRest).After(earliest) {
+				earliest = end.Add(config.MinRest)
+			}
+		}
+
+		court := 0
+		for i := range courtFree {
+			if courtFree[i].Before(courtFree[court]) {
+				court = i
+			}
+		}
+
+		start := earliest
+		if courtFree[court].After(start) {
+			start = courtFree[court]
+		}
+		if !match.StartedAt.IsZero() {
+			start = match.StartedAt
+		}
+
+		end := start.Add(config.MatchDuration)
+		switch {
+		case !match.CompletedAt.IsZero():
+			end = match.CompletedAt
+			if match.StartedAt.IsZero() {
+				start = end.Add(-config.MatchDuration)
+			}
+		case !match.StartedAt.IsZero() && config.Now.After(end):
+			// Running over; assume it finishes any moment
+			end = config.Now
+		}
+
+		if end.After(courtFree[court]) {
+			courtFree[court] = end
+		}
+		ends[match.ID] = end
+		schedule.Slots = append(schedule.Slots, ScheduledMatch{
+			MatchID: match.ID,
+			Court:   court,
+			Start:   start,
+			End:     end,
+		})
+	}
+
+	sort.SliceStable(schedule.Slots, func(i, j int) bool {
+		if !schedule.Slots[i].Start.Equal(schedule.Slots[j].Start) {
+			return schedule.Slots[i].Start.Before(schedule.Slots[j].Start)
+		}
+		return schedule.Slots[i].Court < schedule.Slots[j].Court
+	})
+	for i, slot := range schedule.Slots {
+		schedule.byMatch[slot.MatchID] = i
+	}
+
+	return schedule, nil
+}
+
+// ForMatch returns the slot for a match, or false for byes and unknown matches.
+func (s *Schedule) ForMatch(matchID int) (ScheduledMatch, bool) {
+	i, ok := s.byMatch[matchID]
+	if !ok {
+		return ScheduledMatch{}, false
+	}
+	return s.Slots[i], true
+}
+
+// WriteSchedule writes a printable, time-ordered schedule of bracket's matches to w.
+func WriteSchedule(w io.Writer, bracket *Bracket, schedule *Schedule) error {
+	if _, err := fmt.Fprintf(w, "%s\n%s\n\n", i18n.T("Tournament Schedule"),
+		i18n.N(schedule.Config.Courts, "Starts %s • %d min per match • %d courts",
+			schedule.Config.Start.Format("Mon 2 Jan 2006 15:04"),
+			int(schedule.Config.MatchDuration.Minutes()), schedule.Config.Courts)); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join([]string{i18n.T("Time"), i18n.T("Court"), i18n.T("Round"), i18n.T("Match")}, "\t"))
+	for _, slot := range schedule.Slots {
+		match, err := bracket.MatchByID(slot.MatchID)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+			slot.Start.Format("15:04"),
+			i18n.T("Court %d", slot.Court+1),
+			matchRoundName(bracket, match),
+			matchupText(match, 0),
+		)
+	}
+	return tw.Flush()
+}
+
+// scheduleOrder returns the matches to schedule in play order: by round, with
+// the third-place match ahead of the final, then by position. Byes are skipped.
+func scheduleOrder(bracket *Bracket) []*Match {
+	var matches []*Match
+	for i := range bracket.Matches {
+		if !bracket.Matches[i].IsBye {
+			matches = append(matches, &bracket.Matches[i])
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Round != b.Round {
+			return a.Round < b.Round
+		}
+		if a.IsThirdPlace != b.IsThirdPlace {
+			return a.IsThirdPlace
+		}
+		return a.Position < b.Position
+	})
+	return matches
+}
+
+// feederMatches returns the matches whose winner or loser moves into matchID.
+func feederMatches(bracket *Bracket, matchID int) []*Match {
+	var feeders []*Match
+	for i := range bracket.Matches {
+		match := &bracket.Matches[i]
+		if match.NextMatchID == matchID || match.LoserNextMatchID == matchID {
+			feeders = append(feeders, match)
+		}
+	}
+	return feeders
+}
@@ -0,0 +1,112 @@
+package tournament
+
+import (
+	"testing"
+	"time"
+)
+
+// scheduleStart is a fixed start so schedules in tests do not depend on the clock.
+var scheduleStart = time.Date(2026, 5, 16, 9, 0, 0, 0, time.UTC)
+
+func newScheduleBracket(t *testing.T, players int) *Bracket {
+	t.Helper()
+	entries := make([]Player, players)
+	for i := range entries {
+		entries[i] = Player{Name: string(rune('A' + i))}
+	}
+	bracket, err := NewBracketWithPlayers(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bracket
+}
+
+func TestScheduleWaitsForFeeders(t *testing.T) {
+	bracket := newScheduleBracket(t, 4)
+	schedule, err := NewSchedule(bracket, ScheduleConfig{
+		Start:         scheduleStart,
+		MatchDuration: 30 * time.Minute,
+		Courts:        4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, match := range bracket.Matches {
+		slot, ok := schedule.ForMatch(match.ID)
+		if !ok {
+			t.Fatalf("match %d is not scheduled", match.ID)
+		}
+		for _, feeder := range feederMatches(bracket, match.ID) {
+			feederSlot, _ := schedule.ForMatch(feeder.ID)
+			if slot.Start.Before(feederSlot.End) {
+				t.Errorf("match %d starts at %s, before feeder %d ends at %s",
+					match.ID, slot.Start.Format("15:04"), feeder.ID, feederSlot.End.Format("15:04"))
+			}
+		}
+	}
+}
+
+func TestScheduleMinRest(t *testing.T) {
+	tests := []struct {
+		rest      time.Duration
+		wantFinal time.Time
+	}{
+		{0, scheduleStart.Add(30 * time.Minute)},
+		{10 * time.Minute, scheduleStart.Add(40 * time.Minute)},
+		{45 * time.Minute, scheduleStart.Add(75 * time.Minute)},
+	}
+	for _, tt := range tests {
+		bracket := newScheduleBracket(t, 4)
+		schedule, err := NewSchedule(bracket, ScheduleConfig{
+			Start:         scheduleStart,
+			MatchDuration: 30 * time.Minute,
+			Courts:        2,
+			MinRest:       tt.rest,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		slot, _ := schedule.ForMatch(bracket.FinalMatch().ID)
+		if !slot.Start.Equal(tt.wantFinal) {
+			t.Errorf("rest %s: final starts at %s, want %s",
+				tt.rest, slot.Start.Format("15:04"), tt.wantFinal.Format("15:04"))
+		}
+	}
+}
+
+func TestScheduleTakesEarliestFreeCourt(t *testing.T) {
+	bracket := newScheduleBracket(t, 8)
+	schedule, err := NewSchedule(bracket, ScheduleConfig{
+		Start:         scheduleStart,
+		MatchDuration: 30 * time.Minute,
+		Courts:        3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Three first-round matches fill the courts; the fourth waits for the
+	// first court to free up, and ties go to the lowest court
+	want := []struct {
+		court int
+		start time.Time
+	}{
+		{0, scheduleStart},
+		{1, scheduleStart},
+		{2, scheduleStart},
+		{0, scheduleStart.Add(30 * time.Minute)},
+	}
+	firstRound := bracket.MatchesInRound(0)
+	for i, w := range want {
+		match := firstRound[i]
+		slot, ok := schedule.ForMatch(match.ID)
+		if !ok {
+			t.Fatalf("match %d is not scheduled", match.ID)
+		}
+		if slot.Court != w.court || !slot.Start.Equal(w.start) {
+			t.Errorf("round 1 match %d on court %d at %s, want court %d at %s", i+1,
+				slot.Court+1, slot.Start.Format("15:04"), w.court+1, w.start.Format("15:04"))
+		}
+	}
+}
@@ -1,10 +1,20 @@
 package tournament
 
 import (
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"go-tournament/i18n"
+	"go-tournament/keymap"
+	"go-tournament/theme"
 )
 
 type SEState int
@@ -13,115 +23,1389 @@ const (
 	SEStateSetup SEState = iota
 	SEStateBracketView
 	SEStateMatchEntry
+	SEStateResults
+	SEStateReports
+	SEStatePlayers
+	SEStateField
 )
 
+// fieldEdit is the name being typed on the players screen, if any.
+type fieldEdit int
+
+const (
+	fieldEditNone   fieldEdit = iota
+	fieldEditAdd              // Typing a late entry's name
+	fieldEditRename           // Typing a new name for the player under the cursor
+)
+
+// ReportsChangedMsg is sent when remote result reports arrive or change, so
+// the report queue is redrawn.
+type ReportsChangedMsg struct{}
+
+// ChangeMsg delivers a store event to the screen, so changes made elsewhere,
+// e.g. by another organizer sharing the store, are picked up.
+type ChangeMsg struct {
+	Event Event
+}
+
 type SingleEliminationModel struct {
 	state            SEState
 	participantCount int
 	minParticipants  int
 	maxParticipants  int
+	thirdPlaceMatch  bool
+	courtCount       int // Courts to call matches onto (0 disables court management)
+	maxCourts        int
+	matchDuration    time.Duration   // Average match length used for the schedule
+	restDuration     time.Duration   // Minimum rest between a player's matches
+	startDelay       time.Duration   // How long after the next quarter hour the first matches start
+	exportDir        string          // Directory printable schedules are written to
+	schedules        *scheduleCache  // Schedule last estimated, shared by copies of the model
+	store            *Store          // Owns the bracket; shared with other observers
+	tx               *Txn            // Change in progress while Update runs
+	cmd              tea.Cmd         // Work queued by later, returned once Update is done
+	tournamentID     int64           // Store's tournament ID, bound while Update or View runs
+	tournamentName   string          // Store's tournament name, bound while Update or View runs
+	bracket          *Bracket        // Store's bracket, bound while Update or View runs
+	courts           *CourtManager   // Store's courts, bound while Update or View runs
+	scheduleConfig   ScheduleConfig  // Store's schedule settings, bound while Update or View runs
+	calendars        *CalendarFeeds  // Calendar feeds kept up to date once enabled (nil if unavailable)
+	reports          *ReportQueue    // Remote result reports awaiting approval (nil if disabled)
+	tokens           *PlayerTokens   // Reporting tokens issued to players (nil if disabled)
+	selectedReport   int             // Index of the report under the cursor
+	showTokens       bool            // Show player tokens instead of the report queue
+	selectedMatch    int             // ID of the match under the bracket cursor
+	finding          bool            // Typing a name to find in the bracket view
+	findQuery        string          // Name being found
+	findHits         []int           // IDs of the players matching findQuery, best first
+	findHit          int             // Index of the hit being shown
+	tracedPlayer     int             // ID of the player whose path is highlighted (-1 for none)
+	entryWinner      int             // Player slot picked on the match entry screen (0 or 1)
+	seedTiebreak     bool            // Break tied placings by seed on the results screen
+	statusMsg        string          // Feedback from the last action, e.g. a rejected result
+	directory        PlayerDirectory // Registry to pick participants from (nil if unavailable)
+	roster           []Player        // Registered players picked for the next bracket, in seed order
+	pickerQuery      string          // Search text on the participant picker
+	pickerResults    []Player        // Directory players matching pickerQuery
+	pickerSelected   int             // Index of the result under the cursor
+	fieldSelected    int             // Seed index of the player under the cursor on the players screen
+	fieldEdit        fieldEdit       // Name being typed on the players screen
+	fieldName        string          // Name typed so far
+	ratings          RatingSource    // Ratings for seeding picked players (nil if unavailable)
+	seedByRating     bool            // Seed picked players by rating instead of the order picked
+	zoom             BracketZoom     // Detail drawn for each match in the bracket view
+	names            NameLayout      // How wide the bracket draws player names
+	view             viewport        // Part of the bracket on screen when it does not fit
+	focusRound       int             // First round shown when the terminal is too narrow for every round
+	keys             keymap.Keymap
 	width            int
 	height           int
 }
 
 var (
+	seHeaderStyle            lipgloss.Style
+	seHelpStyle              lipgloss.Style
+	seCountStyle             lipgloss.Style
+	seInfoBoxStyle           lipgloss.Style
+	seWarningStyle           lipgloss.Style
+	seLimitStyle             lipgloss.Style
+	seEntryCardStyle         lipgloss.Style
+	seSelectedEntryCardStyle lipgloss.Style
+	seChampionStyle          lipgloss.Style
+	sePlacingStyle           lipgloss.Style
+	sePodiumStyle            lipgloss.Style
+)
+
+// applySingleEliminationTheme styles the tournament screens in the theme's colours.
+func applySingleEliminationTheme(t theme.Theme) {
 	seHeaderStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#FF6B6B")).
-			Align(lipgloss.Center).
-			MarginBottom(2)
+		Bold(true).
+		Foreground(t.Header.Terminal()).
+		Align(lipgloss.Center).
+		MarginBottom(2)
 
 	seHelpStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#626262")).
-			Align(lipgloss.Center).
-			MarginTop(2)
+		Foreground(t.Muted.Terminal()).
+		Align(lipgloss.Center).
+		MarginTop(2)
 
 	seCountStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#4ECDC4")).
-			Align(lipgloss.Center)
+		Bold(true).
+		Foreground(t.Info.Terminal()).
+		Align(lipgloss.Center)
 
 	seInfoBoxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#626262")).
-			Padding(1, 2).
-			Align(lipgloss.Center)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Muted.Terminal()).
+		Padding(1, 2).
+		Align(lipgloss.Center)
+
+	seWarningStyle = lipgloss.NewStyle().
+		Foreground(t.Warning.Terminal()).
+		Italic(true).
+		Align(lipgloss.Center)
+
+	seLimitStyle = lipgloss.NewStyle().
+		Foreground(t.Danger.Terminal()).
+		Align(lipgloss.Center)
+
+	seEntryCardStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Border.Terminal()).
+		Padding(1, 2).
+		Width(22).
+		Align(lipgloss.Center)
+
+	seSelectedEntryCardStyle = seEntryCardStyle.
+		BorderForeground(t.Selected.Terminal()).
+		Background(t.SelectedBg.Terminal()).
+		Reverse(t.Reverse)
+
+	seChampionStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Warning.Terminal()).
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(t.Warning.Terminal()).
+		Padding(1, 4).
+		Align(lipgloss.Center)
+
+	sePlacingStyle = lipgloss.NewStyle().
+		Foreground(t.Text.Terminal())
+
+	sePodiumStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Info.Terminal())
+}
+
+func NewSingleEliminationModel() SingleEliminationModel {
+	return SingleEliminationModel{
+		state:            SEStateSetup,
+		participantCount: 8,
+		minParticipants:  2,
+		maxParticipants:  MaxParticipants,
+		maxCourts:        8,
+		matchDuration:    30 * time.Minute,
+		restDuration:     10 * time.Minute,
+		schedules:        &scheduleCache{},
+		store:            NewStore(),
+		tracedPlayer:     -1,
+		names:            DefaultNameLayout,
+		keys:             keymap.Default(),
+	}
+}
+
+// WithKeymap replaces the default key bindings.
+func (m SingleEliminationModel) WithKeymap(keys keymap.Keymap) SingleEliminationModel {
+	m.keys = keys
+	return m
+}
+
+// WithStore makes the screen run the tournament held by store, so it can be
+// shared with other screens and observers. If store already has a bracket,
+// the screen opens on it.
+func (m SingleEliminationModel) WithStore(store *Store) SingleEliminationModel {
+	m.store = store
+	m.schedules = &scheduleCache{}
+	store.View(func(t Tournament) {
+		if t.Bracket == nil {
+			return
+		}
+		m.bind(t)
+		m.participantCount = len(t.Bracket.Participants)
+		m.selectedMatch = m.nextPlayableMatch()
+		m.state = SEStateBracketView
+	})
+	return m
+}
+
+// WithReporting enables the remote result report queue. Reports submitted to
+// queue by players holding tokens are reviewed on the reports screen.
+func (m SingleEliminationModel) WithReporting(queue *ReportQueue, tokens *PlayerTokens) SingleEliminationModel {
+	m.reports = queue
+	m.tokens = tokens
+	return m
+}
+
+// WithCalendars lets the organizer publish iCalendar feeds of the
+// tournament, kept up to date from then on.
+func (m SingleEliminationModel) WithCalendars(feeds *CalendarFeeds) SingleEliminationModel {
+	m.calendars = feeds
+	return m
+}
+
+// WithExportDir sets the directory printable schedules are written to.
+func (m SingleEliminationModel) WithExportDir(dir string) SingleEliminationModel {
+	m.exportDir = dir
+	return m
+}
+
+// WithDirectory lets setup pick participants from a player registry.
+func (m SingleEliminationModel) WithDirectory(directory PlayerDirectory) SingleEliminationModel {
+	m.directory = directory
+	return m
+}
+
+// WithRatings lets setup seed picked players by rating, so the strongest
+// players meet as late as possible.
+func (m SingleEliminationModel) WithRatings(source RatingSource) SingleEliminationModel {
+	m.ratings = source
+	return m
+}
+
+// WithNameLayout sets how wide the bracket draws player names.
+func (m SingleEliminationModel) WithNameLayout(names NameLayout) SingleEliminationModel {
+	m.names = names
+	return m
+}
+
+func (m SingleEliminationModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles key presses inside a store update, so the bracket cannot
+// change under them and every change they make is announced to the store's
+// subscribers. Other messages, such as resizes, mouse scrolling and change
+// notices, only read the tournament and so leave other sessions unblocked.
+func (m SingleEliminationModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(tea.KeyMsg); !ok {
+		m.store.View(func(t Tournament) {
+			m.bind(t)
+			m = m.update(msg)
+		})
+		return m.takeCmd()
+	}
+
+	err := m.store.Update(func(tx *Txn) error {
+		m.tx = tx
+		m.bind(tx.Tournament())
+		m = m.update(msg)
+		m.tx = nil
+		return nil
+	})
+	if err != nil {
+		m.statusMsg = err.Error()
+	}
+	return m.takeCmd()
+}
+
+// later queues cmd to run once Update returns and the store is unlocked, for
+// file and database work that must not hold up the other sessions.
+func (m *SingleEliminationModel) later(cmd tea.Cmd) {
+	m.cmd = tea.Batch(m.cmd, cmd)
+}
+
+// takeCmd returns the model with the work queued by later, clearing the queue.
+func (m SingleEliminationModel) takeCmd() (tea.Model, tea.Cmd) {
+	cmd := m.cmd
+	m.cmd = nil
+	return m, cmd
+}
+
+// exportDoneMsg reports a file export run in the background.
+type exportDoneMsg struct {
+	done string // Status to show once written; empty to leave the status alone
+	err  error
+}
+
+// playersFoundMsg carries directory search results for the picker.
+type playersFoundMsg struct {
+	query   string
+	results []Player
+	err     error
+}
+
+// playerRegisteredMsg carries a player just added to the directory.
+type playerRegisteredMsg struct {
+	player Player
+	err    error
+}
+
+func (m SingleEliminationModel) update(msg tea.Msg) SingleEliminationModel {
+	prevState, prevSelected, prevZoom := m.state, m.selectedMatch, m.zoom
+	reveal := false
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		reveal = true
+
+	case ChangeMsg:
+		m = m.followChange(msg.Event)
+
+	case exportDoneMsg:
+		if msg.err != nil {
+			m.statusMsg = msg.err.Error()
+		} else if msg.done != "" {
+			m.statusMsg = msg.done
+		}
+
+	case ExportFailedMsg:
+		m.statusMsg = msg.Err.Error()
+
+	case playersFoundMsg:
+		// Typing may have moved on while the search ran
+		if msg.query == m.pickerQuery {
+			m.showPlayers(msg.results, msg.err)
+		}
+
+	case playerRegisteredMsg:
+		if msg.err != nil {
+			m.statusMsg = msg.err.Error()
+			break
+		}
+		m.togglePick(msg.player)
+		m.pickerQuery = ""
+		m.later(m.searchPlayers())
+
+	case tea.MouseMsg:
+		switch m.state {
+		case SEStateSetup:
+			m = m.updateSetupMouse(msg)
+		case SEStateBracketView:
+			m = m.updateBracketMouse(msg)
+		}
+
+	case tea.KeyMsg:
+		switch m.state {
+		case SEStateSetup:
+			m = m.updateSetup(msg)
+		case SEStateBracketView:
+			if m.finding {
+				m = m.updateFind(msg)
+			} else {
+				m = m.updateBracketView(msg)
+			}
+		case SEStateMatchEntry:
+			m = m.updateMatchEntry(msg)
+		case SEStateResults:
+			m = m.updateResults(msg)
+		case SEStateReports:
+			m = m.updateReports(msg)
+		case SEStatePlayers:
+			m = m.updatePlayers(msg)
+		case SEStateField:
+			m = m.updateField(msg)
+		}
+	}
+
+	if m.state == SEStateBracketView && m.bracket != nil {
+		// Follow the cursor, but leave manual scrolling alone otherwise
+		reveal = reveal || m.state != prevState || m.selectedMatch != prevSelected || m.zoom != prevZoom
+		m.layoutBracket(reveal)
+	}
+	return m
+}
+
+// bind points the model at the store's tournament for the current Update or View.
+func (m *SingleEliminationModel) bind(t Tournament) {
+	m.tournamentID = t.ID
+	m.tournamentName = t.Name
+	m.bracket = t.Bracket
+	m.courts = t.Courts
+	m.scheduleConfig = t.Schedule
+}
+
+// followChange keeps this screen and cursor where they still make sense after
+// the tournament changed, possibly from another session.
+func (m SingleEliminationModel) followChange(event Event) SingleEliminationModel {
+	if m.bracket == nil {
+		return m
+	}
+	if _, err := m.bracket.MatchByID(m.selectedMatch); err != nil {
+		m.selectedMatch = m.nextPlayableMatch()
+	}
+	if _, err := m.bracket.PathOf(m.tracedPlayer); err != nil || event.Kind == EventBracketCreated {
+		m.tracedPlayer = -1
+		m.finding = false
+	}
+
+	switch m.state {
+	case SEStateSetup:
+		if event.Kind == EventBracketCreated {
+			m.participantCount = len(m.bracket.Participants)
+			m.selectedMatch = m.nextPlayableMatch()
+			m.state = SEStateBracketView
+		}
+	case SEStateMatchEntry:
+		if match, err := m.bracket.MatchByID(m.selectedMatch); err != nil || match.Status.IsDecided() {
+			m.statusMsg = i18n.T("Result was entered by another organizer")
+			m.state = SEStateBracketView
+		}
+	case SEStateResults:
+		if !m.bracket.IsComplete {
+			m.state = SEStateBracketView
+		}
+	case SEStateField:
+		m.fieldSelected = min(m.fieldSelected, len(m.bracket.Participants)-1)
+	}
+	return m
+}
+
+// CapturesEsc reports whether the current state uses Esc to step back within
+// the tournament screen rather than leaving it.
+func (m SingleEliminationModel) CapturesEsc() bool {
+	return m.state != SEStateSetup
+}
+
+// CapturesInput reports whether the screen is taking typed text, so single
+// letters such as q must not be treated as shortcuts.
+func (m SingleEliminationModel) CapturesInput() bool {
+	return m.state == SEStatePlayers || m.state == SEStateBracketView && m.finding ||
+		m.state == SEStateField && m.fieldEdit != fieldEditNone
+}
+
+// ShowsBracket reports whether the screen is showing a drawn bracket, from
+// which the big-screen presentation can be opened.
+func (m SingleEliminationModel) ShowsBracket() bool {
+	return m.state == SEStateBracketView && m.bracket != nil && !m.finding
+}
+
+// HelpKeys returns the bindings for the current state, for help lines and
+// the help overlay. Bindings for features that are not available are disabled.
+func (m SingleEliminationModel) HelpKeys() []key.Binding {
+	k := m.keys
+	k.Setup.PickPlayers.SetEnabled(k.Setup.PickPlayers.Enabled() && m.directory != nil)
+	k.Setup.SeedByRating.SetEnabled(k.Setup.SeedByRating.Enabled() && m.directory != nil && m.ratings != nil)
+	k.Bracket.Reports.SetEnabled(k.Bracket.Reports.Enabled() && m.reports != nil)
+
+	var bindings []key.Binding
+	switch m.state {
+	case SEStateSetup:
+		bindings = keymap.Bindings(k.Setup)
+	case SEStateBracketView:
+		if m.finding {
+			bindings = keymap.Bindings(k.Find)
+		} else {
+			bindings = keymap.Bindings(k.Bracket)
+		}
+	case SEStateMatchEntry:
+		bindings = keymap.Bindings(k.Entry)
+	case SEStateReports:
+		bindings = append([]key.Binding{k.List.Up, k.List.Down}, keymap.Bindings(k.Reports)...)
+	case SEStateResults:
+		bindings = keymap.Bindings(k.Results)
+	case SEStatePlayers:
+		bindings = keymap.Bindings(k.Picker)
+	case SEStateField:
+		if m.fieldEdit != fieldEditNone {
+			bindings = []key.Binding{k.Field.Save}
+		} else {
+			bindings = []key.Binding{k.List.Up, k.List.Down, k.Field.Add, k.Field.Remove,
+				k.Field.Rename, k.Field.SeedUp, k.Field.SeedDown, k.Field.Redraw}
+		}
+	}
+	return append(bindings, k.Global.Back)
+}
+
+func (m SingleEliminationModel) updateSetup(msg tea.KeyMsg) SingleEliminationModel {
+	m.statusMsg = ""
+
+	switch {
+	case key.Matches(msg, m.keys.Setup.Increase):
+		m.adjustParticipants(1)
+	case key.Matches(msg, m.keys.Setup.Decrease):
+		m.adjustParticipants(-1)
+	case key.Matches(msg, m.keys.Setup.PickPlayers):
+		if m.directory != nil {
+			m.pickerQuery = ""
+			m.pickerSelected = 0
+			m.later(m.searchPlayers())
+			m.state = SEStatePlayers
+		}
+	case key.Matches(msg, m.keys.Setup.SeedByRating):
+		if m.ratings != nil {
+			m.seedByRating = !m.seedByRating
+		}
+	case key.Matches(msg, m.keys.Setup.ThirdPlace):
+		m.thirdPlaceMatch = !m.thirdPlaceMatch
+	case key.Matches(msg, m.keys.Setup.Courts):
+		m.courtCount = (m.courtCount + 1) % (m.maxCourts + 1)
+	case key.Matches(msg, m.keys.Setup.Duration):
+		m.matchDuration = nextMatchDuration(m.matchDuration)
+	case key.Matches(msg, m.keys.Setup.StartLater):
+		m.startDelay = min(m.startDelay+scheduleStartStep, maxStartDelay)
+	case key.Matches(msg, m.keys.Setup.StartEarlier):
+		m.startDelay = max(m.startDelay-scheduleStartStep, 0)
+	case key.Matches(msg, m.keys.Setup.Rest):
+		m.restDuration = nextRestDuration(m.restDuration)
+	case key.Matches(msg, m.keys.Setup.Start):
+		// Validate, build the bracket and transition to bracket view
+		if m.participantCount >= m.minParticipants && m.participantCount <= m.maxParticipants {
+			players, err := m.participants()
+			if err != nil {
+				m.statusMsg = err.Error()
+				return m
+			}
+			bracket, err := NewBracketWithPlayers(players, m.bracketOptions()...)
+			if err != nil {
+				m.statusMsg = err.Error()
+				return m
+			}
+			t := Tournament{
+				Bracket: bracket,
+				Schedule: ScheduleConfig{
+					Start:         m.scheduleStart(time.Now()),
+					MatchDuration: m.matchDuration,
+					Courts:        max(m.courtCount, 1), // Schedule as one court without court management
+					MinRest:       m.restDuration,
+				},
+			}
+			if m.courtCount > 0 {
+				t.Courts = NewCourtManager(bracket, m.courtCount)
+			}
+			m.tx.Create(t)
+			m.bind(t)
+			m.bracketChanged()
+			m.selectedMatch = m.nextPlayableMatch()
+			m.statusMsg = ""
+			m.state = SEStateBracketView
+		}
+	}
+	return m
+}
+
+// adjustParticipants changes the participant count by delta within the limits.
+// Picked players always get a slot.
+func (m *SingleEliminationModel) adjustParticipants(delta int) {
+	m.participantCount = max(min(m.participantCount+delta, m.maxParticipants),
+		m.minParticipants, len(m.roster))
+}
+
+// updateSetupMouse lets the mouse wheel adjust the participant count.
+func (m SingleEliminationModel) updateSetupMouse(msg tea.MouseMsg) SingleEliminationModel {
+	if msg.Action != tea.MouseActionPress {
+		return m
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.adjustParticipants(1)
+	case tea.MouseButtonWheelDown:
+		m.adjustParticipants(-1)
+	}
+	return m
+}
+
+// updatePlayers handles the participant picker: typing searches the registry,
+// the pick key picks or unpicks the highlighted player, or registers the
+// typed name when nobody matches.
+func (m SingleEliminationModel) updatePlayers(msg tea.KeyMsg) SingleEliminationModel {
+	m.statusMsg = ""
+
+	switch {
+	case key.Matches(msg, m.keys.Global.Back):
+		m.state = SEStateSetup
+	case key.Matches(msg, m.keys.Picker.Up):
+		if m.pickerSelected > 0 {
+			m.pickerSelected--
+		}
+	case key.Matches(msg, m.keys.Picker.Down):
+		if m.pickerSelected < len(m.pickerResults)-1 {
+			m.pickerSelected++
+		}
+	case msg.Type == tea.KeyBackspace:
+		if runes := []rune(m.pickerQuery); len(runes) > 0 {
+			m.pickerQuery = string(runes[:len(runes)-1])
+			m.later(m.searchPlayers())
+		}
+	case msg.Type == tea.KeyRunes, msg.Type == tea.KeySpace:
+		m.pickerQuery += string(msg.Runes)
+		m.later(m.searchPlayers())
+	case key.Matches(msg, m.keys.Picker.Pick):
+		if m.pickerSelected < len(m.pickerResults) {
+			m.togglePick(m.pickerResults[m.pickerSelected])
+			return m
+		}
+		if strings.TrimSpace(m.pickerQuery) == "" {
+			return m
+		}
+		m.later(m.registerPlayer(strings.TrimSpace(m.pickerQuery)))
+	}
+	return m
+}
+
+// updateField handles the players screen of a drawn bracket: late entries,
+// withdrawals, seed moves and a fresh draw before play starts, and renaming
+// at any time.
+func (m SingleEliminationModel) updateField(msg tea.KeyMsg) SingleEliminationModel {
+	m.statusMsg = ""
+	if m.fieldEdit != fieldEditNone {
+		return m.updateFieldName(msg)
+	}
+
+	participants := m.bracket.Participants
+	selected := participants[m.fieldSelected]
+	var err error
+	switch {
+	case key.Matches(msg, m.keys.Global.Back):
+		m.state = SEStateBracketView
+	case key.Matches(msg, m.keys.List.Up):
+		if m.fieldSelected > 0 {
+			m.fieldSelected--
+		}
+	case key.Matches(msg, m.keys.List.Down):
+		if m.fieldSelected < len(participants)-1 {
+			m.fieldSelected++
+		}
+	case key.Matches(msg, m.keys.Field.Add):
+		switch {
+		case m.bracket.HasResults():
+			err = ErrResultsRecorded
+		case len(participants) >= MaxParticipants:
+			err = ErrTooManyParticipants
+		default:
+			m.fieldEdit, m.fieldName = fieldEditAdd, ""
+		}
+	case key.Matches(msg, m.keys.Field.Rename):
+		m.fieldEdit, m.fieldName = fieldEditRename, selected.Name
+	case key.Matches(msg, m.keys.Field.Remove):
+		if err = m.tx.RemoveParticipant(selected.ID); err == nil {
+			m.fieldSelected = min(m.fieldSelected, len(m.bracket.Participants)-1)
+			m.fieldChanged()
+		}
+	case key.Matches(msg, m.keys.Field.SeedUp):
+		if m.fieldSelected > 0 {
+			if err = m.tx.SwapSeeds(selected.ID, participants[m.fieldSelected-1].ID); err == nil {
+				m.fieldSelected--
+				m.fieldChanged()
+			}
+		}
+	case key.Matches(msg, m.keys.Field.SeedDown):
+		if m.fieldSelected < len(participants)-1 {
+			if err = m.tx.SwapSeeds(selected.ID, participants[m.fieldSelected+1].ID); err == nil {
+				m.fieldSelected++
+				m.fieldChanged()
+			}
+		}
+	case key.Matches(msg, m.keys.Field.Redraw):
+		shuffled := copyParticipants(participants)
+		rand.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i].Seed, shuffled[j].Seed = shuffled[j].Seed, shuffled[i].Seed
+		})
+		if err = m.tx.Redraw(shuffled); err == nil {
+			m.fieldSelected = m.seedIndex(selected.ID)
+			m.fieldChanged()
+		}
+	}
+	if err != nil {
+		m.statusMsg = err.Error()
+	}
+	return m
+}
+
+// updateFieldName handles typing a late entry's name or a new name for the
+// player under the cursor.
+func (m SingleEliminationModel) updateFieldName(msg tea.KeyMsg) SingleEliminationModel {
+	switch {
+	case key.Matches(msg, m.keys.Global.Back):
+		m.fieldEdit = fieldEditNone
+	case msg.Type == tea.KeyBackspace:
+		if runes := []rune(m.fieldName); len(runes) > 0 {
+			m.fieldName = string(runes[:len(runes)-1])
+		}
+	case msg.Type == tea.KeyRunes, msg.Type == tea.KeySpace:
+		m.fieldName += string(msg.Runes)
+	case key.Matches(msg, m.keys.Field.Save):
+		name := strings.TrimSpace(m.fieldName)
+		if name == "" {
+			m.statusMsg = ErrEmptyName.Error()
+			return m
+		}
+		if m.fieldEdit == fieldEditAdd {
+			player, err := m.tx.AddParticipant(name)
+			if err != nil {
+				m.statusMsg = err.Error()
+				return m
+			}
+			m.fieldSelected = m.seedIndex(player.ID)
+			m.fieldChanged()
+		} else if err := m.tx.RenamePlayer(m.bracket.Participants[m.fieldSelected].ID, name); err != nil {
+			m.statusMsg = err.Error()
+			return m
+		}
+		m.fieldEdit = fieldEditNone
+	}
+	return m
+}
+
+// fieldChanged follows up a change to the players: the draw was rebuilt, so
+// match IDs and paths through the bracket no longer hold.
+func (m *SingleEliminationModel) fieldChanged() {
+	m.bracketChanged()
+	m.selectedMatch = m.nextPlayableMatch()
+	m.tracedPlayer = -1
+}
+
+// seedIndex returns where a player sits in seed order, or 0 if they are not
+// in the bracket.
+func (m SingleEliminationModel) seedIndex(playerID int) int {
+	for i, p := range m.bracket.Participants {
+		if p.ID == playerID {
+			return i
+		}
+	}
+	return 0
+}
+
+// searchPlayers returns a command searching the directory for the current query.
+func (m SingleEliminationModel) searchPlayers() tea.Cmd {
+	directory, query := m.directory, m.pickerQuery
+	return func() tea.Msg {
+		results, err := directory.SearchPlayers(query)
+		return playersFoundMsg{query: query, results: results, err: err}
+	}
+}
+
+// registerPlayer returns a command adding a player to the directory.
+func (m SingleEliminationModel) registerPlayer(name string) tea.Cmd {
+	directory := m.directory
+	return func() tea.Msg {
+		player, err := directory.RegisterPlayer(name)
+		return playerRegisteredMsg{player: player, err: err}
+	}
+}
+
+// showPlayers puts search results on the picker, keeping the cursor in range.
+func (m *SingleEliminationModel) showPlayers(results []Player, err error) {
+	if err != nil {
+		m.statusMsg = err.Error()
+		results = nil
+	}
+	m.pickerResults = results
+	if m.pickerSelected >= len(results) {
+		m.pickerSelected = max(len(results)-1, 0)
+	}
+}
+
+// togglePick adds a registered player to the roster, or removes them if
+// already picked. The roster grows the participant count as needed.
+func (m *SingleEliminationModel) togglePick(player Player) {
+	for i, picked := range m.roster {
+		if picked.RegistryID == player.RegistryID {
+			m.roster = append(m.roster[:i:i], m.roster[i+1:]...)
+			return
+		}
+	}
+	if len(m.roster) >= m.maxParticipants {
+		m.statusMsg = i18n.T("A bracket holds at most %d players", m.maxParticipants)
+		return
+	}
+	m.roster = append(m.roster, player)
+	m.participantCount = max(m.participantCount, len(m.roster))
+}
+
+// isPicked reports whether a registered player is on the roster.
+func (m SingleEliminationModel) isPicked(player Player) bool {
+	for _, picked := range m.roster {
+		if picked.RegistryID == player.RegistryID {
+			return true
+		}
+	}
+	return false
+}
+
+// participants returns the players for a new bracket: picked players first,
+// in the order picked or by rating, then numbered placeholders for the
+// remaining slots.
+func (m SingleEliminationModel) participants() ([]Player, error) {
+	players := make([]Player, 0, m.participantCount)
+	if m.seedByRating && m.ratings != nil {
+		ratings, err := m.ratings.Ratings()
+		if err != nil {
+			return nil, err
+		}
+		players = append(players, SeedByRating(m.roster, ratings)...)
+	} else {
+		players = append(players, m.roster...)
+	}
+	for i := len(players); i < m.participantCount; i++ {
+		players = append(players, Player{Name: i18n.T("Player %d", i+1)})
+	}
+	return players, nil
+}
+
+func (m SingleEliminationModel) updateBracketView(msg tea.KeyMsg) SingleEliminationModel {
+	m.statusMsg = ""
+
+	switch {
+	case key.Matches(msg, m.keys.Global.Back):
+		// Stop highlighting a player first, then return to setup
+		if m.tracedPlayer >= 0 {
+			m.tracedPlayer = -1
+			return m
+		}
+		m.state = SEStateSetup
+	case key.Matches(msg, m.keys.Bracket.Find):
+		m.finding = true
+		m.findQuery = ""
+		m.findPlayers()
+	case key.Matches(msg, m.keys.Bracket.Follow):
+		if match, err := m.bracket.MatchByID(m.selectedMatch); err == nil {
+			m.tracedPlayer = nextFollowed(match, m.tracedPlayer)
+		}
+	case key.Matches(msg, m.keys.Bracket.Up):
+		m.moveSelection(0, -1)
+	case key.Matches(msg, m.keys.Bracket.Down):
+		m.moveSelection(0, 1)
+	case key.Matches(msg, m.keys.Bracket.Left):
+		m.moveSelection(-1, 0)
+	case key.Matches(msg, m.keys.Bracket.Right):
+		m.moveSelection(1, 0)
+	case key.Matches(msg, m.keys.Bracket.PageUp):
+		m.view.y -= m.view.height
+	case key.Matches(msg, m.keys.Bracket.PageDown):
+		m.view.y += m.view.height
+	case key.Matches(msg, m.keys.Bracket.PageLeft):
+		m.view.x -= m.view.width
+	case key.Matches(msg, m.keys.Bracket.PageRight):
+		m.view.x += m.view.width
+	case key.Matches(msg, m.keys.Bracket.Zoom):
+		m.zoom = m.zoom.Next()
+	case key.Matches(msg, m.keys.Bracket.NextRound):
+		m.stepFocus(1)
+	case key.Matches(msg, m.keys.Bracket.PrevRound):
+		m.stepFocus(-1)
+	case key.Matches(msg, m.keys.Bracket.NextMatch):
+		m.selectedMatch = m.nextPlayableMatch()
+	case key.Matches(msg, m.keys.Bracket.StartStop):
+		// Toggle whether the selected match is being played
+		match, err := m.bracket.MatchByID(m.selectedMatch)
+		if err != nil {
+			return m
+		}
+		switch match.Status {
+		case MatchReady:
+			err = m.tx.StartMatch(match.ID)
+		case MatchInProgress:
+			err = m.tx.StopMatch(match.ID)
+		default:
+			m.statusMsg = i18n.T("Match is %s and cannot be started", i18n.T(match.Status.String()))
+		}
+		if err != nil {
+			m.statusMsg = err.Error()
+		}
+		m.bracketChanged()
+	case key.Matches(msg, m.keys.Bracket.Record):
+		match, err := m.bracket.MatchByID(m.selectedMatch)
+		if err != nil {
+			return m
+		}
+		if match.Status == MatchReady || match.Status == MatchInProgress {
+			m.entryWinner = 0
+			m.state = SEStateMatchEntry
+		}
+	case key.Matches(msg, m.keys.Bracket.Results):
+		if m.bracket.IsComplete {
+			m.state = SEStateResults
+		}
+	case key.Matches(msg, m.keys.Bracket.ExportSchedule):
+		m.later(m.exportSchedule(filepath.Join(m.exportDir, scheduleExportFile)))
+	case key.Matches(msg, m.keys.Bracket.Reports):
+		if m.reports != nil {
+			m.selectedReport = 0
+			m.showTokens = false
+			m.state = SEStateReports
+		}
+	case key.Matches(msg, m.keys.Bracket.EditPlayers):
+		m.fieldSelected = 0
+		m.fieldEdit = fieldEditNone
+		m.state = SEStateField
+	case key.Matches(msg, m.keys.Bracket.Calendars):
+		if feeds := m.calendars; feeds != nil {
+			m.later(func() tea.Msg {
+				return exportDoneMsg{
+					done: i18n.T("Calendars written to %s/ and kept up to date", feeds.Dir()),
+					err:  feeds.Enable(),
+				}
+			})
+		}
+	}
+	return m
+}
+
+// updateFind handles typing a name to find in the bracket view. Each change
+// to the name jumps to the best match; the find keys step through the rest.
+func (m SingleEliminationModel) updateFind(msg tea.KeyMsg) SingleEliminationModel {
+	switch {
+	case key.Matches(msg, m.keys.Global.Back):
+		m.finding = false
+		m.tracedPlayer = -1
+	case key.Matches(msg, m.keys.Find.Done):
+		m.finding = false
+	case key.Matches(msg, m.keys.Find.Next):
+		if len(m.findHits) > 0 {
+			m.findHit = (m.findHit + 1) % len(m.findHits)
+			m.showHit()
+		}
+	case key.Matches(msg, m.keys.Find.Prev):
+		if len(m.findHits) > 0 {
+			m.findHit = (m.findHit + len(m.findHits) - 1) % len(m.findHits)
+			m.showHit()
+		}
+	case msg.Type == tea.KeyBackspace:
+		if runes := []rune(m.findQuery); len(runes) > 0 {
+			m.findQuery = string(runes[:len(runes)-1])
+			m.findPlayers()
+		}
+	case msg.Type == tea.KeyRunes, msg.Type == tea.KeySpace:
+		m.findQuery += string(msg.Runes)
+		m.findPlayers()
+	}
+	return m
+}
+
+// nextFollowed returns which player of a match to follow after the one
+// followed now: the first player, then the second, then nobody (-1).
+func nextFollowed(match *Match, followed int) int {
+	players := []*Player{match.Player1, match.Player2}
+	start := 0
+	for i, p := range players {
+		if p != nil && p.ID == followed {
+			start = i + 1
+		}
+	}
+	for _, p := range players[start:] {
+		if p != nil {
+			return p.ID
+		}
+	}
+	return -1
+}
+
+// findPlayers refreshes the players matching the find query and shows the best.
+func (m *SingleEliminationModel) findPlayers() {
+	m.findHits = nil
+	for _, player := range m.bracket.FindPlayers(m.findQuery) {
+		m.findHits = append(m.findHits, player.ID)
+	}
+	m.findHit = 0
+	m.showHit()
+}
+
+// showHit highlights the path of the player being shown and moves the cursor
+// to the match they are playing or waiting for, or their last one.
+func (m *SingleEliminationModel) showHit() {
+	if len(m.findHits) == 0 {
+		m.tracedPlayer = -1
+		return
+	}
+	m.tracedPlayer = m.findHits[m.findHit]
+	if path, err := m.bracket.PathOf(m.tracedPlayer); err == nil && path.Latest() != nil {
+		m.selectedMatch = path.Latest().ID
+	}
+}
+
+const (
+	wheelScrollRows    = 3 // Rows scrolled per wheel step
+	wheelScrollColumns = 8 // Columns scrolled per sideways wheel step
+)
+
+// updateBracketMouse scrolls the bracket with the wheel (sideways with Shift)
+// and selects a clicked match, opening result entry if it can be played.
+func (m SingleEliminationModel) updateBracketMouse(msg tea.MouseMsg) SingleEliminationModel {
+	if msg.Action != tea.MouseActionPress {
+		return m
+	}
+
+	switch {
+	case msg.Button == tea.MouseButtonWheelLeft, msg.Button == tea.MouseButtonWheelUp && msg.Shift:
+		m.view.x -= wheelScrollColumns
+	case msg.Button == tea.MouseButtonWheelRight, msg.Button == tea.MouseButtonWheelDown && msg.Shift:
+		m.view.x += wheelScrollColumns
+	case msg.Button == tea.MouseButtonWheelUp:
+		m.view.y -= wheelScrollRows
+	case msg.Button == tea.MouseButtonWheelDown:
+		m.view.y += wheelScrollRows
+	case msg.Button == tea.MouseButtonLeft:
+		match := m.matchAt(msg.X, msg.Y)
+		if match == nil {
+			return m
+		}
+		m.statusMsg = ""
+		m.selectedMatch = match.ID
+		if match.Status == MatchReady || match.Status == MatchInProgress {
+			m.entryWinner = 0
+			m.state = SEStateMatchEntry
+		}
+	}
+	return m
+}
+
+// matchAt returns the match drawn at a screen cell, or nil if there is none.
+func (m SingleEliminationModel) matchAt(x, y int) *Match {
+	sections, index := m.bracketSections()
+	left, top := PlacedAt(m.width, m.height, sections, index)
+	x, y = x-left, y-top
+	if x < 0 || y < 0 || (m.view.width > 0 && x >= m.view.width) || (m.view.height > 0 && y >= m.view.height) {
+		return nil
+	}
+	x, y = x+m.view.x, y+m.view.y
+
+	renderer := m.newRenderer()
+	for i := range m.bracket.Matches {
+		match := &m.bracket.Matches[i]
+		if match.Round < renderer.firstRound || match.Round > renderer.lastRound {
+			continue
+		}
+		matchX, matchY, width, height := renderer.matchBounds(match)
+		if x >= matchX && x < matchX+width && y >= matchY && y < matchY+height {
+			return match
+		}
+	}
+	return nil
+}
+
+func (m SingleEliminationModel) updateMatchEntry(msg tea.KeyMsg) SingleEliminationModel {
+	walkover := key.Matches(msg, m.keys.Entry.Walkover)
+	switch {
+	case key.Matches(msg, m.keys.Global.Back):
+		m.state = SEStateBracketView
+	case key.Matches(msg, m.keys.Entry.Player1):
+		m.entryWinner = 0
+	case key.Matches(msg, m.keys.Entry.Player2):
+		m.entryWinner = 1
+	case key.Matches(msg, m.keys.Entry.Confirm), walkover:
+		match, err := m.bracket.MatchByID(m.selectedMatch)
+		if err != nil {
+			m.statusMsg = err.Error()
+			m.state = SEStateBracketView
+			return m
+		}
+
+		winner := match.Player1
+		if m.entryWinner == 1 {
+			winner = match.Player2
+		}
+		record := m.tx.RecordResult
+		if walkover {
+			record = m.tx.RecordWalkover
+		}
+		if err := record(match.ID, winner.ID); err != nil {
+			m.statusMsg = err.Error()
+			m.state = SEStateBracketView
+			return m
+		}
+		if m.reports != nil {
+			m.reports.ClearMatch(match.ID)
+		}
+		m.bracketChanged()
+
+		if m.bracket.IsComplete {
+			m.state = SEStateResults
+			return m
+		}
+		m.selectedMatch = m.nextPlayableMatch()
+		m.state = SEStateBracketView
+	}
+	return m
+}
+
+func (m SingleEliminationModel) updateReports(msg tea.KeyMsg) SingleEliminationModel {
+	pending := m.reports.Pending()
+	m.statusMsg = ""
+
+	switch {
+	case key.Matches(msg, m.keys.Global.Back):
+		m.state = SEStateBracketView
+	case key.Matches(msg, m.keys.List.Up):
+		if m.selectedReport > 0 {
+			m.selectedReport--
+		}
+	case key.Matches(msg, m.keys.List.Down):
+		if m.selectedReport < len(pending)-1 {
+			m.selectedReport++
+		}
+	case key.Matches(msg, m.keys.Reports.ShowTokens):
+		m.showTokens = !m.showTokens
+	case key.Matches(msg, m.keys.Reports.Approve):
+		if m.selectedReport < len(pending) {
+			report := pending[m.selectedReport]
+			// The draw may have changed since the report came in
+			err := m.bracket.CheckReport(report)
+			if err == nil {
+				err = m.tx.RecordResult(report.MatchID, report.WinnerID)
+			}
+			switch {
+			case errors.Is(err, ErrStaleReport):
+				m.reports.Reject(report.ID)
+				m.statusMsg = err.Error()
+			case err != nil:
+				m.statusMsg = err.Error()
+			default:
+				m.reports.ClearMatch(report.MatchID)
+				m.bracketChanged()
+			}
+		}
+	case key.Matches(msg, m.keys.Reports.Reject):
+		if m.selectedReport < len(pending) {
+			if err := m.reports.Reject(pending[m.selectedReport].ID); err != nil {
+				m.statusMsg = err.Error()
+			}
+		}
+	}
+
+	if remaining := len(m.reports.Pending()); m.selectedReport >= remaining && remaining > 0 {
+		m.selectedReport = remaining - 1
+	}
+	return m
+}
+
+func (m SingleEliminationModel) updateResults(msg tea.KeyMsg) SingleEliminationModel {
+	switch {
+	case key.Matches(msg, m.keys.Global.Back):
+		m.state = SEStateBracketView
+	case key.Matches(msg, m.keys.Results.SeedTiebreak):
+		m.seedTiebreak = !m.seedTiebreak
+	}
+	return m
+}
+
+// bracketChanged follows up a bracket change: it frees courts whose matches
+// have finished and calls the next queued matches onto them. Reporting
+// tokens follow the participants through FollowReporting.
+func (m *SingleEliminationModel) bracketChanged() {
+	m.tx.RefreshCourts()
+}
+
+const (
+	// scheduleStartStep rounds the first start time up to the next quarter
+	// hour, and is how far setup moves it at a time
+	scheduleStartStep = 15 * time.Minute
+
+	// maxStartDelay is how far past the next quarter hour setup can move the start
+	maxStartDelay = 24 * time.Hour
+
+	// scheduleExportFile is the printable schedule's name in the export directory
+	scheduleExportFile = "schedule.txt"
+)
+
+// matchDurations are the average match lengths the setup screen cycles through.
+var matchDurations = []time.Duration{
+	15 * time.Minute,
+	20 * time.Minute,
+	30 * time.Minute,
+	45 * time.Minute,
+	60 * time.Minute,
+}
+
+// restDurations are the minimum rests between matches the setup screen cycles through.
+var restDurations = []time.Duration{
+	0,
+	5 * time.Minute,
+	10 * time.Minute,
+	15 * time.Minute,
+	20 * time.Minute,
+	30 * time.Minute,
+}
+
+// nextMatchDuration returns the duration after d in matchDurations, wrapping around.
+func nextMatchDuration(d time.Duration) time.Duration {
+	return nextDuration(matchDurations, d)
+}
+
+// nextRestDuration returns the rest after d in restDurations, wrapping around.
+func nextRestDuration(d time.Duration) time.Duration {
+	return nextDuration(restDurations, d)
+}
+
+// nextDuration returns the duration after d in durations, wrapping around.
+func nextDuration(durations []time.Duration, d time.Duration) time.Duration {
+	for i, duration := range durations {
+		if duration == d {
+			return durations[(i+1)%len(durations)]
+		}
+	}
+	return durations[0]
+}
+
+// scheduleStart returns when the first matches of a bracket created at now
+// begin: the next quarter hour, moved later on the setup screen.
+func (m SingleEliminationModel) scheduleStart(now time.Time) time.Time {
+	return now.Truncate(scheduleStartStep).Add(scheduleStartStep + m.startDelay)
+}
+
+// scheduleCache holds the schedule last estimated for a store revision.
+// Estimates only change with the tournament or as time passes, so the
+// schedule is rebuilt once a store event has been published or the minute
+// shown on it has moved on, rather than on every frame.
+type scheduleCache struct {
+	valid    bool
+	revision int
+	minute   time.Time
+	schedule *Schedule
+	err      error
+}
+
+// schedule estimates start times for the current bracket from the settings it
+// was created with, taking recorded progress up to now into account.
+func (m SingleEliminationModel) schedule() (*Schedule, error) {
+	// Only called while Update or View holds the store's lock
+	revision := m.store.revision
+	minute := time.Now().Truncate(time.Minute)
+	cache := m.schedules
+	if !cache.valid || cache.revision != revision || !cache.minute.Equal(minute) {
+		schedule, err := scheduleOf(m.tournament())
+		*cache = scheduleCache{valid: true, revision: revision, minute: minute, schedule: schedule, err: err}
+	}
+	return cache.schedule, cache.err
+}
+
+// tournament returns the store's tournament as bound for this Update or View.
+func (m SingleEliminationModel) tournament() Tournament {
+	return Tournament{
+		ID:       m.tournamentID,
+		Name:     m.tournamentName,
+		Bracket:  m.bracket,
+		Courts:   m.courts,
+		Schedule: m.scheduleConfig,
+	}
+}
+
+// exportSchedule returns a command writing the printable schedule to path
+// from a snapshot.
+func (m SingleEliminationModel) exportSchedule(path string) tea.Cmd {
+	t := m.tournament().Snapshot()
+	return func() tea.Msg {
+		return exportDoneMsg{
+			done: i18n.T("Schedule written to %s", path),
+			err:  writeScheduleFile(path, t),
+		}
+	}
+}
 
-	seWarningStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFD93D")).
-			Italic(true).
-			Align(lipgloss.Center)
+// scheduleOf estimates start times for a tournament from now.
+func scheduleOf(t Tournament) (*Schedule, error) {
+	config := t.Schedule
+	config.Now = time.Now()
+	return NewSchedule(t.Bracket, config)
+}
 
-	seLimitStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FF6B6B")).
-			Align(lipgloss.Center)
-)
+// writeScheduleFile writes the printable schedule of a tournament to path,
+// creating its directory if needed.
+func writeScheduleFile(path string, t Tournament) error {
+	schedule, err := scheduleOf(t)
+	if err != nil {
+		return err
+	}
 
-func NewSingleEliminationModel() SingleEliminationModel {
-	return SingleEliminationModel{
-		state:            SEStateSetup,
-		participantCount: 8,
-		minParticipants:  2,
-		maxParticipants:  64,
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
 	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteSchedule(f, t.Bracket, schedule); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
 }
 
-func (m SingleEliminationModel) Init() tea.Cmd {
-	return nil
+// selectableMatches returns the matches the cursor can visit in a round,
+// including the third-place match alongside the final.
+func (m SingleEliminationModel) selectableMatches(round int) []*Match {
+	matches := m.bracket.MatchesInRound(round)
+	if round == m.bracket.TotalRounds-1 {
+		if thirdPlace := m.bracket.ThirdPlaceMatch(); thirdPlace != nil {
+			matches = append(matches, thirdPlace)
+		}
+	}
+	return matches
 }
 
-func (m SingleEliminationModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
+// moveSelection moves the bracket cursor by whole rounds or by matches within a round.
+// Moving between rounds follows the bracket tree, so the cursor lands on the
+// match the current one feeds into, or the first of its feeders.
+func (m *SingleEliminationModel) moveSelection(roundDelta, indexDelta int) {
+	current, err := m.bracket.MatchByID(m.selectedMatch)
+	if err != nil {
+		return
+	}
 
-	case tea.KeyMsg:
-		switch m.state {
-		case SEStateSetup:
-			switch msg.String() {
-			case "+", "j", "up":
-				if m.participantCount < m.maxParticipants {
-					m.participantCount++
-				}
-			case "-", "k", "down":
-				if m.participantCount > m.minParticipants {
-					m.participantCount--
-				}
-			case "enter":
-				// Validate and transition to bracket view
-				if m.participantCount >= m.minParticipants && m.participantCount <= m.maxParticipants {
-					m.state = SEStateBracketView
-				}
-			}
+	round := current.Round + roundDelta
+	if round < 0 || round >= m.bracket.TotalRounds {
+		return
+	}
 
-		case SEStateBracketView:
-			switch msg.String() {
-			case "esc":
-				// Return to setup
-				m.state = SEStateSetup
-			}
+	index := current.Position
+	switch {
+	case roundDelta > 0:
+		index /= 2
+	case roundDelta < 0:
+		index *= 2
+	}
+	index += indexDelta
+
+	matches := m.selectableMatches(round)
+	if index < 0 || len(matches) == 0 {
+		return
+	}
+	if index >= len(matches) {
+		if roundDelta == 0 {
+			return
+		}
+		index = len(matches) - 1
+	}
+	m.selectedMatch = matches[index].ID
+}
+
+// nextPlayableMatch returns the first ready or in-progress match in bracket
+// order. Falls back to the final once everything is decided.
+func (m SingleEliminationModel) nextPlayableMatch() int {
+	for _, match := range m.bracket.Matches {
+		if match.Status == MatchReady || match.Status == MatchInProgress {
+			return match.ID
 		}
 	}
-	return m, nil
+	if final := m.bracket.FinalMatch(); final != nil {
+		return final.ID
+	}
+	return 0
+}
+
+// bracketOptions returns the generation options chosen on the setup screen.
+func (m SingleEliminationModel) bracketOptions() []BracketOption {
+	var opts []BracketOption
+	if m.thirdPlaceMatch {
+		opts = append(opts, WithThirdPlaceMatch())
+	}
+	return opts
 }
 
+// View renders under the store's read lock, so the bracket is never drawn mid-change.
 func (m SingleEliminationModel) View() string {
+	var view string
+	m.store.View(func(t Tournament) {
+		m.bind(t)
+		view = m.render()
+	})
+	return view
+}
+
+func (m SingleEliminationModel) render() string {
 	switch m.state {
 	case SEStateSetup:
 		return m.renderSetupView()
 	case SEStateBracketView:
 		return m.renderBracketView()
 	case SEStateMatchEntry:
-		// TODO: Phase 4 - Match entry view
-		return m.renderBracketView()
+		return m.renderMatchEntryView()
+	case SEStateResults:
+		return m.renderResultsView()
+	case SEStateReports:
+		return m.renderReportsView()
+	case SEStatePlayers:
+		return m.renderPlayersView()
+	case SEStateField:
+		return m.renderFieldView()
 	default:
 		return m.renderSetupView()
 	}
 }
 
 func (m SingleEliminationModel) renderSetupView() string {
-	header := seHeaderStyle.Render("🥊 Single Elimination Tournament")
+	header := seHeaderStyle.Render(i18n.T("🥊 Single Elimination Tournament"))
 
 	// Calculate bracket properties
 	rounds := CalculateRounds(m.participantCount)
@@ -130,22 +1414,47 @@ func (m SingleEliminationModel) renderSetupView() string {
 	matchesPerRound := CalculateMatchesPerRound(m.participantCount)
 
 	// Participant count display (large and prominent)
-	countText := fmt.Sprintf("Participants: %d", m.participantCount)
+	countText := i18n.T("Participants: %d", m.participantCount)
 	countDisplay := seCountStyle.Render(countText)
 
 	// Show limits feedback
 	var limitMsg string
 	if m.participantCount == m.minParticipants {
-		limitMsg = seLimitStyle.Render(fmt.Sprintf("(minimum: %d)", m.minParticipants))
+		limitMsg = seLimitStyle.Render(i18n.T("(minimum: %d)", m.minParticipants))
 	} else if m.participantCount == m.maxParticipants {
-		limitMsg = seLimitStyle.Render(fmt.Sprintf("(maximum: %d)", m.maxParticipants))
+		limitMsg = seLimitStyle.Render(i18n.T("(maximum: %d)", m.maxParticipants))
 	}
 
 	// Bracket info section
 	var infoLines []string
-	infoLines = append(infoLines, fmt.Sprintf("Bracket Size: %d", bracketSize))
-	infoLines = append(infoLines, fmt.Sprintf("Rounds: %d", rounds))
-	infoLines = append(infoLines, fmt.Sprintf("Byes: %d", byes))
+	infoLines = append(infoLines, i18n.T("Bracket Size: %d", bracketSize))
+	infoLines = append(infoLines, i18n.T("Rounds: %d", rounds))
+	infoLines = append(infoLines, i18n.T("Byes: %d", byes))
+	if m.participantCount >= 4 {
+		thirdPlace := i18n.T("Off")
+		if m.thirdPlaceMatch {
+			thirdPlace = i18n.T("On")
+		}
+		infoLines = append(infoLines, i18n.T("Third-place match: %s", thirdPlace))
+	}
+	if m.courtCount > 0 {
+		infoLines = append(infoLines, i18n.T("Courts: %d", m.courtCount))
+	} else {
+		infoLines = append(infoLines, i18n.T("Courts: not managed"))
+	}
+	infoLines = append(infoLines, i18n.T("Match duration: %d min", int(m.matchDuration.Minutes())))
+	infoLines = append(infoLines, i18n.T("First matches: %s", m.scheduleStart(time.Now()).Format("15:04")))
+	infoLines = append(infoLines, i18n.T("Rest between matches: %d min", int(m.restDuration.Minutes())))
+	if m.directory != nil {
+		infoLines = append(infoLines, i18n.T("Registered players: %d of %d", len(m.roster), m.participantCount))
+	}
+	if m.ratings != nil {
+		seeding := i18n.T("Order picked")
+		if m.seedByRating {
+			seeding = i18n.T("By rating")
+		}
+		infoLines = append(infoLines, i18n.T("Seeding: %s", seeding))
+	}
 	infoLines = append(infoLines, "")
 
 	// Round breakdown
@@ -155,15 +1464,15 @@ func (m SingleEliminationModel) renderSetupView() string {
 
 		var line string
 		if roundName != "" {
-			line = fmt.Sprintf("Round %d: %d matches (%s)", roundNum, matches, roundName)
+			line = i18n.N(matches, "Round %d: %d matches (%s)", roundNum, matches, roundName)
 		} else {
-			line = fmt.Sprintf("Round %d: %d matches", roundNum, matches)
+			line = i18n.N(matches, "Round %d: %d matches", roundNum, matches)
 		}
 
 		// Add player count for round 1
 		if i == 0 && byes > 0 {
 			playersInRound1 := m.participantCount - byes
-			line = fmt.Sprintf("Round %d: %d matches (%d players)", roundNum, matches, playersInRound1)
+			line = i18n.N(matches, "Round %d: %d matches (%d players)", roundNum, matches, playersInRound1)
 		}
 
 		infoLines = append(infoLines, line)
@@ -174,11 +1483,10 @@ func (m SingleEliminationModel) renderSetupView() string {
 	// Bye warning
 	var byeWarning string
 	if byes > 0 {
-		byeWarning = seWarningStyle.Render(fmt.Sprintf("[%d players get byes]", byes))
+		byeWarning = seWarningStyle.Render(i18n.N(byes, "[%d players get byes]", byes))
 	}
 
-	// Help text
-	help := seHelpStyle.Render("+ - or j k to adjust • Enter to continue • Esc to go back")
+	help := seHelpStyle.Render(keymap.ShortHelp(append(m.HelpKeys(), m.keys.Global.Help)...))
 
 	// Combine all sections
 	sections := []string{header, "", countDisplay}
@@ -193,6 +1501,10 @@ func (m SingleEliminationModel) renderSetupView() string {
 		sections = append(sections, "", byeWarning)
 	}
 
+	if m.statusMsg != "" {
+		sections = append(sections, "", seWarningStyle.Render(m.statusMsg))
+	}
+
 	sections = append(sections, "", help)
 
 	view := lipgloss.JoinVertical(lipgloss.Center, sections...)
@@ -205,29 +1517,401 @@ func (m SingleEliminationModel) renderSetupView() string {
 }
 
 func (m SingleEliminationModel) renderBracketView() string {
-	header := seHeaderStyle.Render("🥊 Single Elimination Tournament")
+	sections, _ := m.bracketSections()
+	view := lipgloss.JoinVertical(lipgloss.Center, sections...)
 
-	// Display current configuration
-	configText := fmt.Sprintf("Tournament with %d participants", m.participantCount)
-	rounds := CalculateRounds(m.participantCount)
-	roundsText := fmt.Sprintf("%d rounds", rounds)
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		view,
+	)
+}
+
+// bracketSections returns the parts of the bracket view from top to bottom,
+// and which of them holds the bracket.
+func (m SingleEliminationModel) bracketSections() (sections []string, bracketIndex int) {
+	above, below := m.bracketChrome()
+
+	renderer := m.newRenderer()
+	full := renderer.Render()
+	bracketView := m.view.crop(full)
+	if panels := m.renderSidePanels(); panels != "" {
+		bracketView = lipgloss.JoinHorizontal(lipgloss.Top, bracketView, "    ", panels)
+	}
+
+	// Say where the window is when the bracket does not fit
+	viewText := i18n.T("Zoom: %s", i18n.T(m.zoom.String()))
+	fullWidth, fullHeight := lipgloss.Width(full), lipgloss.Height(full)
+	if m.view.width > 0 && fullWidth > m.view.width {
+		viewText += i18n.T(" • columns %d–%d of %d", m.view.x+1, min(m.view.x+m.view.width, fullWidth), fullWidth)
+	}
+	if m.view.height > 0 && fullHeight > m.view.height {
+		viewText += i18n.T(" • rows %d–%d of %d", m.view.y+1, min(m.view.y+m.view.height, fullHeight), fullHeight)
+	}
+
+	sections = append(above, bracketView, seLimitStyle.Render(viewText))
+	return append(sections, below...), len(above)
+}
+
+// bracketChrome returns what the bracket view shows above and below the
+// bracket itself, leaving out the one-line zoom and scroll position.
+func (m SingleEliminationModel) bracketChrome() (above, below []string) {
+	header := seHeaderStyle.Render(i18n.T("🥊 Single Elimination Tournament"))
+
+	// Display current configuration and what table staff need right now
+	configText := i18n.T("Tournament with %d participants • %d rounds • %d in progress • %d ready",
+		len(m.bracket.Participants), m.bracket.TotalRounds,
+		len(m.bracket.MatchesWithStatus(MatchInProgress)), len(m.bracket.MatchesWithStatus(MatchReady)))
+	above = []string{header, configText, newBracketRenderer(m.bracket, -1).renderLegend(), ""}
+	if m.focusRounds() > 0 {
+		above = append(above, m.newRenderer().renderRoundTabs(), "")
+	}
+
+	if m.reports != nil {
+		if pending := len(m.reports.Pending()); pending > 0 {
+			below = append(below, "", seWarningStyle.Render(i18n.N(pending, "📨 %d result reports awaiting approval • %s to review",
+				pending, m.keys.Bracket.Reports.Help().Key)))
+		}
+	}
+	if line := m.renderFindLine(); line != "" {
+		below = append(below, "", line)
+	}
+	if m.statusMsg != "" {
+		below = append(below, "", seWarningStyle.Render(m.statusMsg))
+	}
+
+	k := m.keys.Bracket
+	helpText := keymap.ShortHelp(k.StartStop, k.Record, k.NextMatch, k.Find, k.ExportSchedule, k.Calendars,
+		m.keys.Global.Back, m.keys.Global.Help)
+	switch {
+	case m.finding:
+		helpText = i18n.T("Type a name • ") + keymap.ShortHelp(m.HelpKeys()...)
+	case m.bracket.IsComplete:
+		helpText = keymap.ShortHelp(k.Results, k.Find, m.keys.Global.Back, m.keys.Global.Help)
+	}
+	viewHelp := keymap.ShortHelp(k.PageUp, k.PageDown, k.PageLeft, k.PageRight, k.Zoom) +
+		i18n.T(" • the mouse wheel scrolls • click a match to record it")
+	if m.focusRounds() > 0 {
+		viewHelp += " • " + keymap.ShortHelp(k.NextRound, k.PrevRound)
+	}
+	below = append(below, seHelpStyle.Render(helpText), seLimitStyle.Render(viewHelp))
+
+	// Wrap long lines on narrow terminals rather than letting them push the bracket aside
+	if m.width > 0 {
+		wrap := lipgloss.NewStyle().Width(m.width).Align(lipgloss.Center)
+		for _, lines := range [][]string{above, below} {
+			for i, line := range lines {
+				if lipgloss.Width(line) > m.width {
+					lines[i] = wrap.Render(line)
+				}
+			}
+		}
+	}
+	return above, below
+}
+
+// renderFindLine shows the name being found and which player is shown, or
+// whose path is highlighted once finding is done. It is empty otherwise.
+func (m SingleEliminationModel) renderFindLine() string {
+	if !m.finding {
+		if m.tracedPlayer < 0 {
+			return ""
+		}
+		return sePodiumStyle.Render(i18n.T("Path of %s • %s to clear",
+			m.playerName(m.tracedPlayer), m.keys.Global.Back.Help().Key))
+	}
+
+	line := i18n.T("Find player: %s▏", m.findQuery)
+	switch {
+	case len(m.findHits) > 0:
+		line += "   " + i18n.T("%d of %d: %s", m.findHit+1, len(m.findHits), m.playerName(m.tracedPlayer))
+	case strings.TrimSpace(m.findQuery) != "":
+		line += "   " + i18n.T("Nobody matches")
+	}
+	return seCountStyle.Render(line)
+}
+
+// maxFocusRounds is the most rounds shown at once in focus mode.
+const maxFocusRounds = 2
+
+// newRenderer returns a renderer for the bracket at the current zoom, limited
+// to the focused rounds when every round does not fit.
+func (m SingleEliminationModel) newRenderer() bracketRenderer {
+	renderer := m.allRoundsRenderer()
+	if n := m.focusRounds(); n > 0 {
+		renderer.focus(m.focusRound, n)
+	}
+	return renderer
+}
+
+// allRoundsRenderer returns a renderer for every round at the current zoom.
+func (m SingleEliminationModel) allRoundsRenderer() bracketRenderer {
+	renderer := newBracketRenderer(m.bracket, m.selectedMatch)
+	renderer.zoom = m.zoom
+	renderer.names = m.names
+	if path, err := m.bracket.PathOf(m.tracedPlayer); err == nil {
+		renderer.path = &path
+	}
+	if m.zoom == ZoomFull {
+		if schedule, err := m.schedule(); err == nil {
+			renderer.schedule = schedule
+		}
+	}
+	return renderer
+}
+
+// bracketWidth returns the width left for the bracket beside the side panels.
+func (m SingleEliminationModel) bracketWidth() int {
+	width := m.width
+	if panels := m.renderSidePanels(); panels != "" {
+		width -= lipgloss.Width(panels) + 4
+	}
+	return max(width, 1)
+}
+
+// focusRounds returns how many rounds focus mode shows at once, or 0 when
+// the terminal is wide enough for every round.
+func (m SingleEliminationModel) focusRounds() int {
+	if m.width == 0 {
+		return 0
+	}
+	renderer := m.allRoundsRenderer()
+	if renderer.width() <= m.bracketWidth() {
+		return 0
+	}
+	connector := renderer.layout().connectorWidth
+	fit := (m.bracketWidth() + connector) / (renderer.columnWidth() + connector)
+	return min(max(fit, 1), maxFocusRounds)
+}
+
+// stepFocus shows the next or previous rounds in focus mode, bringing the
+// cursor along the bracket tree so it stays on a visible match.
+func (m *SingleEliminationModel) stepFocus(delta int) {
+	n := m.focusRounds()
+	if n == 0 {
+		return
+	}
+	m.focusRound = max(min(m.focusRound+delta, m.bracket.TotalRounds-n), 0)
+
+	for range m.bracket.TotalRounds {
+		match, err := m.bracket.MatchByID(m.selectedMatch)
+		if err != nil {
+			return
+		}
+		switch {
+		case match.Round < m.focusRound:
+			m.moveSelection(1, 0)
+		case match.Round >= m.focusRound+n:
+			m.moveSelection(-1, 0)
+		default:
+			return
+		}
+	}
+}
+
+// layoutBracket fits the viewport to the space the bracket view leaves for
+// the bracket and keeps it in range. With reveal, it also scrolls the
+// selected match into view.
+func (m *SingleEliminationModel) layoutBracket(reveal bool) {
+	if m.width == 0 || m.height == 0 {
+		m.view = viewport{}
+		return
+	}
+
+	// Focus follows the cursor, e.g. when it moves right past the last round shown
+	if n := m.focusRounds(); n > 0 {
+		if match, err := m.bracket.MatchByID(m.selectedMatch); err == nil {
+			m.focusRound = max(min(m.focusRound, match.Round), match.Round-n+1)
+		}
+		m.focusRound = max(min(m.focusRound, m.bracket.TotalRounds-n), 0)
+	}
+
+	above, below := m.bracketChrome()
+	chrome := lipgloss.Height(lipgloss.JoinVertical(lipgloss.Center, append(above, below...)...)) + 1
+	m.view.width = m.bracketWidth()
+	m.view.height = max(m.height-chrome, 1)
+
+	renderer := m.newRenderer()
+	if match, err := m.bracket.MatchByID(m.selectedMatch); err == nil && reveal {
+		m.view.reveal(renderer.matchBounds(match))
+	}
+	full := renderer.Render()
+	m.view.clamp(lipgloss.Width(full), lipgloss.Height(full))
+}
+
+// renderSidePanels renders what sits beside the bracket: the court panel when
+// courts are managed, then the followed player's route. It is empty when
+// neither is shown.
+func (m SingleEliminationModel) renderSidePanels() string {
+	var panels []string
+	if m.courts != nil {
+		panels = append(panels, m.renderCourtPanel())
+	}
+	if path, err := m.bracket.PathOf(m.tracedPlayer); err == nil {
+		panels = append(panels, m.renderRoutePanel(path))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, panels...)
+}
+
+// routePanelOpponents is how many possible opponents the route panel lists
+// for each round before summing up the rest.
+const routePanelOpponents = 4
+
+// renderRoutePanel lists the rounds left on a player's route with who they
+// could meet in each, answering "who could we get in the semis?".
+func (m SingleEliminationModel) renderRoutePanel(path PlayerPath) string {
+	lines := []string{bracketTracedStyle.Render(truncateName(path.Player.Name, panelNameWidth+4)), ""}
+
+	if len(path.Route) == 0 {
+		status := i18n.T("Out")
+		if final := m.bracket.FinalMatch(); final != nil && final.Winner != nil && final.Winner.ID == path.Player.ID {
+			status = i18n.T("Champion")
+		}
+		lines = append(lines, bracketPlaceholderStyle.Render(status))
+	}
+	for i, step := range path.Route {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, sePodiumStyle.Render(matchRoundName(m.bracket, step.Match)))
+		if len(step.Opponents) == 0 {
+			lines = append(lines, bracketPlaceholderStyle.Render(i18n.T("TBD")))
+		}
+		for j, opponent := range step.Opponents {
+			if j == routePanelOpponents {
+				lines = append(lines, bracketPlaceholderStyle.Render(i18n.N(len(step.Opponents)-j, "and %d others", len(step.Opponents)-j)))
+				break
+			}
+			lines = append(lines, fmt.Sprintf("%s %s", i18n.T("vs"), truncateName(opponent.Name, panelNameWidth)))
+		}
+	}
+
+	return seInfoBoxStyle.Align(lipgloss.Left).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// courtPanelQueueLength is how many upcoming matches the court panel lists.
+const courtPanelQueueLength = 5
+
+// renderCourtPanel renders the "now playing / up next" panel for table staff.
+func (m SingleEliminationModel) renderCourtPanel() string {
+	lines := []string{sePodiumStyle.Render(i18n.T("Now Playing")), ""}
+	for _, court := range m.courts.Courts() {
+		if court.IsFree() {
+			lines = append(lines, fmt.Sprintf("%s: %s", court.Name, bracketPlaceholderStyle.Render(i18n.T("free"))))
+			continue
+		}
+		match, err := m.bracket.MatchByID(court.MatchID)
+		if err != nil {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", court.Name, matchupText(match, panelNameWidth)))
+	}
+
+	lines = append(lines, "", sePodiumStyle.Render(i18n.T("Up Next")), "")
+	queue := m.courts.Queue()
+	if len(queue) == 0 {
+		lines = append(lines, bracketPlaceholderStyle.Render(i18n.T("waiting for results")))
+	}
+	for i, match := range queue {
+		if i == courtPanelQueueLength {
+			lines = append(lines, bracketPlaceholderStyle.Render(i18n.T("+%d more", len(queue)-i)))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, matchupText(match, panelNameWidth)))
+	}
+
+	return seInfoBoxStyle.Align(lipgloss.Left).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// matchLabel returns the round name shown above a match on the entry screen.
+func (m SingleEliminationModel) matchLabel(match *Match) string {
+	if match.IsThirdPlace {
+		return matchRoundName(m.bracket, match)
+	}
+	return i18n.T("%s • Match %d", matchRoundName(m.bracket, match), match.Position+1)
+}
+
+func (m SingleEliminationModel) renderMatchEntryView() string {
+	header := seHeaderStyle.Render(i18n.T("🥊 Record Result"))
+
+	match, err := m.bracket.MatchByID(m.selectedMatch)
+	if err != nil || match.Player1 == nil || match.Player2 == nil {
+		return m.renderBracketView()
+	}
+
+	var cards []string
+	for i, player := range []*Player{match.Player1, match.Player2} {
+		style := seEntryCardStyle
+		if i == m.entryWinner {
+			style = seSelectedEntryCardStyle
+		}
+		content := i18n.T("%s\n\nSeed %d", player.Name, player.Seed)
+		cards = append(cards, style.Render(content))
+	}
 
-	content := lipgloss.JoinVertical(
+	cardsRow := lipgloss.JoinHorizontal(lipgloss.Center, cards[0], "   "+i18n.T("vs")+"   ", cards[1])
+
+	help := seHelpStyle.Render(keymap.ShortHelp(m.HelpKeys()...))
+
+	view := lipgloss.JoinVertical(
 		lipgloss.Center,
+		header,
+		seCountStyle.Render(m.matchLabel(match)),
+		bracketPlaceholderStyle.Render(i18n.T(match.Status.String())),
 		"",
-		configText,
-		roundsText,
-		"",
-		"Bracket view coming in Phase 4...",
-		"",
+		cardsRow,
+		help,
+	)
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		view,
 	)
+}
+
+func (m SingleEliminationModel) renderResultsView() string {
+	header := seHeaderStyle.Render(i18n.T("🥊 Final Standings"))
+
+	var opts []PlacingOption
+	if m.seedTiebreak {
+		opts = append(opts, WithSeedTiebreak())
+	}
+	placings, err := m.bracket.Placings(opts...)
+	if err != nil {
+		return m.renderBracketView()
+	}
+
+	champion := seChampionStyle.Render(i18n.T("🏆  CHAMPION  🏆\n\n%s", placings[0].Player.Name))
 
-	help := seHelpStyle.Render("Press Esc to go back to setup")
+	var lines []string
+	for _, placing := range placings {
+		// Pad by display width, which fmt does not know, for labels and names in wide scripts
+		line := lipgloss.NewStyle().Width(9).Render(placing.Label()) + " " +
+			lipgloss.NewStyle().Width(20).Render(truncateName(placing.Player.Name, 20)) + " " +
+			i18n.T("seed %d", placing.Player.Seed)
+		if placing.Place <= 3 {
+			lines = append(lines, sePodiumStyle.Render(line))
+		} else {
+			lines = append(lines, sePlacingStyle.Render(line))
+		}
+	}
+	table := seInfoBoxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	tiebreak := m.keys.Results.SeedTiebreak
+	if m.seedTiebreak {
+		tiebreak.SetHelp(tiebreak.Help().Key, i18n.T("%s (on)", tiebreak.Help().Desc))
+	} else {
+		tiebreak.SetHelp(tiebreak.Help().Key, i18n.T("%s (off)", tiebreak.Help().Desc))
+	}
+	help := seHelpStyle.Render(keymap.ShortHelp(tiebreak, m.keys.Global.Back))
 
 	view := lipgloss.JoinVertical(
 		lipgloss.Center,
 		header,
-		content,
+		champion,
+		"",
+		table,
 		help,
 	)
 
@@ -237,3 +1921,188 @@ func (m SingleEliminationModel) renderBracketView() string {
 		view,
 	)
 }
+
+// playerName returns the name of a participant, or a placeholder for unknown IDs.
+func (m SingleEliminationModel) playerName(playerID int) string {
+	for _, p := range m.bracket.Participants {
+		if p.ID == playerID {
+			return p.Name
+		}
+	}
+	return i18n.T("Player #%d", playerID)
+}
+
+func (m SingleEliminationModel) renderReportsView() string {
+	header := seHeaderStyle.Render(i18n.T("📨 Result Reports"))
+
+	var lines []string
+	if m.showTokens {
+		for _, p := range m.bracket.Participants {
+			token, _ := m.tokens.Token(p.ID)
+			lines = append(lines, lipgloss.NewStyle().Width(20).Render(truncateName(p.Name, 20))+" "+token)
+		}
+	} else {
+		pending := m.reports.Pending()
+		if len(pending) == 0 {
+			lines = append(lines, bracketPlaceholderStyle.Render(i18n.T("No reports waiting")))
+		}
+		for i, report := range pending {
+			matchText := i18n.T("Match %d", report.MatchID)
+			if match, err := m.bracket.MatchByID(report.MatchID); err == nil {
+				matchText = fmt.Sprintf("%s: %s", matchRoundName(m.bracket, match), matchupText(match, panelNameWidth))
+			}
+			line := i18n.T("%s  %s • %s says %s won",
+				report.ReceivedAt.Format("15:04"), matchText,
+				m.playerName(report.ReporterID), m.playerName(report.WinnerID))
+			if report.Conflict {
+				line += "  " + i18n.T("⚠ conflict")
+			}
+
+			cursor := "  "
+			if i == m.selectedReport {
+				cursor = "> "
+			}
+			switch {
+			case report.Conflict:
+				line = seLimitStyle.Render(cursor + line)
+			case i == m.selectedReport:
+				line = sePodiumStyle.Render(cursor + line)
+			default:
+				line = cursor + line
+			}
+			lines = append(lines, line)
+		}
+	}
+	list := seInfoBoxStyle.Align(lipgloss.Left).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	helpText := keymap.ShortHelp(m.HelpKeys()...)
+	if m.showTokens {
+		helpText = keymap.ShortHelp(m.keys.Reports.ShowTokens, m.keys.Global.Back)
+	}
+
+	sections := []string{header, list}
+	if m.statusMsg != "" {
+		sections = append(sections, "", seWarningStyle.Render(m.statusMsg))
+	}
+	sections = append(sections, seHelpStyle.Render(helpText))
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, sections...),
+	)
+}
+
+// pickerVisibleRows is how many search results the participant picker lists at once.
+const pickerVisibleRows = 10
+
+func (m SingleEliminationModel) renderPlayersView() string {
+	header := seHeaderStyle.Render(i18n.T("👥 Pick Participants"))
+
+	search := seCountStyle.Render(i18n.T("Search: %s▏", m.pickerQuery))
+
+	var lines []string
+	if len(m.pickerResults) == 0 {
+		if strings.TrimSpace(m.pickerQuery) == "" {
+			lines = append(lines, bracketPlaceholderStyle.Render(i18n.T("No registered players yet. Type a name to add one.")))
+		} else {
+			lines = append(lines, bracketPlaceholderStyle.Render(
+				i18n.T("No match. %s to register %q", m.keys.Picker.Pick.Help().Key, strings.TrimSpace(m.pickerQuery))))
+		}
+	}
+
+	// Scroll so the cursor stays visible
+	first := 0
+	if m.pickerSelected >= pickerVisibleRows {
+		first = m.pickerSelected - pickerVisibleRows + 1
+	}
+	last := min(first+pickerVisibleRows, len(m.pickerResults))
+	for i := first; i < last; i++ {
+		player := m.pickerResults[i]
+		check := "[ ]"
+		if m.isPicked(player) {
+			check = "[✓]"
+		}
+		cursor := "  "
+		if i == m.pickerSelected {
+			cursor = "> "
+		}
+		line := fmt.Sprintf("%s%s %s", cursor, check, truncateName(player.Name, 28))
+		if i == m.pickerSelected {
+			line = sePodiumStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	list := seInfoBoxStyle.Align(lipgloss.Left).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	// Picked players in seed order
+	var picked []string
+	for i, p := range m.roster {
+		picked = append(picked, fmt.Sprintf("%d. %s", i+1, p.Name))
+	}
+	pickedText := i18n.T("No players picked")
+	if len(picked) > 0 {
+		pickedText = i18n.T("Seeds: %s", strings.Join(picked, " • "))
+	}
+	summary := sePlacingStyle.Width(min(max(m.width-4, 20), 100)).Align(lipgloss.Center).Render(pickedText)
+
+	sections := []string{header, search, "", list, "", summary}
+	if m.statusMsg != "" {
+		sections = append(sections, "", seWarningStyle.Render(m.statusMsg))
+	}
+	sections = append(sections, seHelpStyle.Render(i18n.T("Type to search • ")+keymap.ShortHelp(m.HelpKeys()...)))
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, sections...),
+	)
+}
+
+// renderFieldView lists the bracket's players in seed order for editing.
+func (m SingleEliminationModel) renderFieldView() string {
+	header := seHeaderStyle.Render(i18n.T("✏️ Players"))
+
+	// Scroll so the cursor stays visible
+	participants := m.bracket.Participants
+	first := 0
+	if m.fieldSelected >= pickerVisibleRows {
+		first = m.fieldSelected - pickerVisibleRows + 1
+	}
+	last := min(first+pickerVisibleRows, len(participants))
+	var lines []string
+	for i := first; i < last; i++ {
+		cursor := "  "
+		if i == m.fieldSelected {
+			cursor = "> "
+		}
+		line := fmt.Sprintf("%s%2d. %s", cursor, participants[i].Seed, truncateName(participants[i].Name, 28))
+		if i == m.fieldSelected {
+			line = sePodiumStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	list := seInfoBoxStyle.Align(lipgloss.Left).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	sections := []string{header, list}
+	switch m.fieldEdit {
+	case fieldEditAdd:
+		sections = append(sections, "", seCountStyle.Render(i18n.T("Late entry: %s▏", m.fieldName)))
+	case fieldEditRename:
+		sections = append(sections, "", seCountStyle.Render(i18n.T("New name: %s▏", m.fieldName)))
+	default:
+		if m.bracket.HasResults() {
+			sections = append(sections, "", sePlacingStyle.Render(i18n.T("Play has started: players can only be renamed")))
+		}
+	}
+	if m.statusMsg != "" {
+		sections = append(sections, "", seWarningStyle.Render(m.statusMsg))
+	}
+	sections = append(sections, seHelpStyle.Render(keymap.ShortHelp(m.HelpKeys()...)))
+
+	return lipgloss.Place(
+		m.width, m.height,
+		lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, sections...),
+	)
+}
@@ -0,0 +1,371 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrNoBracket is returned when changing a store before a bracket is created.
+var ErrNoBracket = errors.New("no bracket has been created")
+
+// EventKind identifies what changed in a Store.
+type EventKind int
+
+const (
+	EventBracketCreated     EventKind = iota // A new bracket replaced the previous one
+	EventBracketRedrawn                      // The draw was rebuilt, e.g. after a seed swap
+	EventParticipantAdded                    // A late entry joined the bracket
+	EventParticipantRemoved                  // A player withdrew before play
+	EventPlayerRenamed                       // A player's name changed
+	EventMatchStarted                        // A match started, by hand or called onto a court
+	EventMatchStopped                        // A match went back to ready
+	EventResultRecorded                      // A match was decided by play
+	EventWalkoverRecorded                    // A match was decided without play
+)
+
+// String returns a human-readable event name.
+func (k EventKind) String() string {
+	switch k {
+	case EventBracketCreated:
+		return "Bracket Created"
+	case EventBracketRedrawn:
+		return "Bracket Redrawn"
+	case EventParticipantAdded:
+		return "Participant Added"
+	case EventParticipantRemoved:
+		return "Participant Removed"
+	case EventPlayerRenamed:
+		return "Player Renamed"
+	case EventMatchStarted:
+		return "Match Started"
+	case EventMatchStopped:
+		return "Match Stopped"
+	case EventResultRecorded:
+		return "Result Recorded"
+	case EventWalkoverRecorded:
+		return "Walkover Recorded"
+	default:
+		return fmt.Sprintf("EventKind(%d)", int(k))
+	}
+}
+
+// Event describes a single change to the tournament in a Store.
+type Event struct {
+	Kind     EventKind
+	Revision int       // Store revision after the change; consecutive events differ by one
+	MatchID  int       // Match concerned (-1 if none)
+	PlayerID int       // Winner, or the player added, removed or renamed (-1 if none)
+	Name     string    // New name for renames and late entries
+	At       time.Time // When the change was made
+}
+
+// Tournament is the live tournament a Store owns: the bracket and the settings
+// used to run it.
+type Tournament struct {
+	ID       int64          // Database ID (0 until saved)
+	Name     string         // Display name (empty until saved)
+	Bracket  *Bracket       // Nil until a bracket is created
+	Courts   *CourtManager  // Nil when courts are not managed
+	Schedule ScheduleConfig // Settings for estimating start times; Now is left unset
+}
+
+// Snapshot returns a deep copy of the tournament that may be kept and read
+// once the store's lock is released, e.g. to write it out in the background.
+func (t Tournament) Snapshot() Tournament {
+	if t.Bracket != nil {
+		t.Bracket = t.Bracket.clone()
+	}
+	if t.Courts != nil {
+		t.Courts = t.Courts.clone(t.Bracket)
+	}
+	return t
+}
+
+// Store owns a live tournament and serializes every change to it, so the TUI,
+// the web server and exporters can share one tournament from different
+// goroutines. Reads go through View and changes through Update; subscribers
+// are told about each change with a typed Event.
+type Store struct {
+	mu         sync.RWMutex
+	tournament Tournament
+	revision   int
+
+	subMu       sync.Mutex
+	subscribers map[int]func(Event)
+	nextSubID   int
+}
+
+// NewStore creates a store without a bracket.
+func NewStore() *Store {
+	return &Store{subscribers: make(map[int]func(Event))}
+}
+
+// View calls fn with the tournament while holding a read lock. The bracket
+// and courts are the live ones: fn must not change them, keep them after it
+// returns, or call back into the store. Take a Snapshot to keep a copy.
+func (s *Store) View(fn func(t Tournament)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.tournament)
+}
+
+// Revision returns the number of changes made so far.
+func (s *Store) Revision() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.revision
+}
+
+// Update runs fn with exclusive access to the tournament. Changes must be made
+// through the Txn so they are announced; subscribers are notified after the
+// lock is released. Events for changes made before fn fails are still sent.
+func (s *Store) Update(fn func(tx *Txn) error) error {
+	tx := &Txn{store: s}
+	err := s.apply(tx, fn)
+	if len(tx.events) > 0 {
+		s.publish(tx.events)
+	}
+	return err
+}
+
+// apply runs fn under the write lock, releasing it even if fn panics so the
+// other sessions and the web server are not locked out for good.
+func (s *Store) apply(tx *Txn, fn func(tx *Txn) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(tx)
+}
+
+// Subscribe registers fn to be called with every event. fn runs on the
+// goroutine that made the change, without the store's lock held, and must not
+// block; hand slow work to another goroutine. Events from changes made on
+// different goroutines may arrive out of order or at the same time, so use
+// Event.Revision when order matters. Call the returned function to unsubscribe.
+func (s *Store) Subscribe(fn func(Event)) (unsubscribe func()) {
+	s.subMu.Lock()
+	defer s.subMu.Unlock()
+
+	id := s.nextSubID
+	s.nextSubID++
+	s.subscribers[id] = fn
+
+	return func() {
+		s.subMu.Lock()
+		defer s.subMu.Unlock()
+		delete(s.subscribers, id)
+	}
+}
+
+func (s *Store) publish(events []Event) {
+	s.subMu.Lock()
+	subscribers := make([]func(Event), 0, len(s.subscribers))
+	for _, fn := range s.subscribers {
+		subscribers = append(subscribers, fn)
+	}
+	s.subMu.Unlock()
+
+	for _, event := range events {
+		for _, fn := range subscribers {
+			fn(event)
+		}
+	}
+}
+
+// Txn is a change in progress inside Store.Update. The bracket may be read
+// freely, but changes must go through Txn methods so subscribers hear of them.
+type Txn struct {
+	store  *Store
+	events []Event
+}
+
+// Tournament returns the tournament being changed. Its bracket and courts are
+// the live ones, valid only until Update returns; take a Snapshot to keep them.
+func (tx *Txn) Tournament() Tournament {
+	return tx.store.tournament
+}
+
+// Bracket returns the bracket being changed, or nil if none was created yet.
+// Like Tournament, it must not be kept once Update returns.
+func (tx *Txn) Bracket() *Bracket {
+	return tx.store.tournament.Bracket
+}
+
+// Create replaces the tournament with a new one.
+func (tx *Txn) Create(t Tournament) {
+	tx.store.tournament = t
+	tx.emit(EventBracketCreated, -1, -1, "")
+}
+
+// SetSaved records the database ID and name the tournament was saved under.
+// It is bookkeeping rather than a change to the tournament, so no event is sent.
+func (tx *Txn) SetSaved(id int64, name string) {
+	tx.store.tournament.ID = id
+	tx.store.tournament.Name = name
+}
+
+// StartMatch marks a ready match as being played. With courts managed, the
+// match is put on the first free court, and is refused if none is free.
+func (tx *Txn) StartMatch(matchID int) error {
+	bracket, err := tx.bracket()
+	if err != nil {
+		return err
+	}
+	if courts := tx.store.tournament.Courts; courts != nil {
+		_, err = courts.Start(matchID)
+	} else {
+		err = bracket.StartMatch(matchID)
+	}
+	if err != nil {
+		return err
+	}
+	tx.emit(EventMatchStarted, matchID, -1, "")
+	return nil
+}
+
+// StopMatch returns an in-progress match to ready. With courts managed, the
+// match is held off the courts until it is started again by hand.
+func (tx *Txn) StopMatch(matchID int) error {
+	bracket, err := tx.bracket()
+	if err != nil {
+		return err
+	}
+	if err := bracket.StopMatch(matchID); err != nil {
+		return err
+	}
+	if courts := tx.store.tournament.Courts; courts != nil {
+		courts.Hold(matchID)
+	}
+	tx.emit(EventMatchStopped, matchID, -1, "")
+	return nil
+}
+
+// RecordResult records the winner of a match and advances the players.
+func (tx *Txn) RecordResult(matchID, winnerID int) error {
+	bracket, err := tx.bracket()
+	if err != nil {
+		return err
+	}
+	if err := bracket.RecordResult(matchID, winnerID); err != nil {
+		return err
+	}
+	tx.emit(EventResultRecorded, matchID, winnerID, "")
+	return nil
+}
+
+// RecordWalkover awards a match without play.
+func (tx *Txn) RecordWalkover(matchID, winnerID int) error {
+	bracket, err := tx.bracket()
+	if err != nil {
+		return err
+	}
+	if err := bracket.RecordWalkover(matchID, winnerID); err != nil {
+		return err
+	}
+	tx.emit(EventWalkoverRecorded, matchID, winnerID, "")
+	return nil
+}
+
+// RenamePlayer changes a player's display name.
+func (tx *Txn) RenamePlayer(playerID int, name string) error {
+	bracket, err := tx.bracket()
+	if err != nil {
+		return err
+	}
+	if err := bracket.RenamePlayer(playerID, name); err != nil {
+		return err
+	}
+	for _, p := range bracket.Participants {
+		if p.ID == playerID {
+			name = p.Name
+		}
+	}
+	tx.emit(EventPlayerRenamed, -1, playerID, name)
+	return nil
+}
+
+// AddParticipant adds a late entry as the lowest seed and redraws the bracket.
+func (tx *Txn) AddParticipant(name string) (Player, error) {
+	bracket, err := tx.bracket()
+	if err != nil {
+		return Player{}, err
+	}
+	player, err := bracket.AddParticipant(name)
+	if err != nil {
+		return Player{}, err
+	}
+	tx.emit(EventParticipantAdded, -1, player.ID, player.Name)
+	return player, nil
+}
+
+// RemoveParticipant withdraws a player and redraws the bracket.
+func (tx *Txn) RemoveParticipant(playerID int) error {
+	bracket, err := tx.bracket()
+	if err != nil {
+		return err
+	}
+	if err := bracket.RemoveParticipant(playerID); err != nil {
+		return err
+	}
+	tx.emit(EventParticipantRemoved, -1, playerID, "")
+	return nil
+}
+
+// SwapSeeds places two players into each other's bracket slots.
+func (tx *Txn) SwapSeeds(playerA, playerB int) error {
+	bracket, err := tx.bracket()
+	if err != nil {
+		return err
+	}
+	if err := bracket.SwapSeeds(playerA, playerB); err != nil {
+		return err
+	}
+	tx.emit(EventBracketRedrawn, -1, -1, "")
+	return nil
+}
+
+// Redraw rebuilds the bracket for a new participant set.
+func (tx *Txn) Redraw(participants []Player) error {
+	bracket, err := tx.bracket()
+	if err != nil {
+		return err
+	}
+	if err := bracket.Redraw(participants); err != nil {
+		return err
+	}
+	tx.emit(EventBracketRedrawn, -1, -1, "")
+	return nil
+}
+
+// RefreshCourts frees courts whose matches are over and calls queued matches
+// onto free courts, announcing each match it starts.
+func (tx *Txn) RefreshCourts() {
+	courts := tx.store.tournament.Courts
+	if courts == nil {
+		return
+	}
+	for _, match := range courts.Refresh() {
+		tx.emit(EventMatchStarted, match.ID, -1, "")
+	}
+}
+
+// bracket returns the bracket, which must exist before it can be changed.
+func (tx *Txn) bracket() (*Bracket, error) {
+	if tx.store.tournament.Bracket == nil {
+		return nil, ErrNoBracket
+	}
+	return tx.store.tournament.Bracket, nil
+}
+
+func (tx *Txn) emit(kind EventKind, matchID, playerID int, name string) {
+	tx.store.revision++
+	tx.events = append(tx.events, Event{
+		Kind:     kind,
+		Revision: tx.store.revision,
+		MatchID:  matchID,
+		PlayerID: playerID,
+		Name:     name,
+		At:       time.Now(),
+	})
+}
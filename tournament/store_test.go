@@ -0,0 +1,55 @@
+package tournament
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUpdateUnlocksAfterPanic(t *testing.T) {
+	store := NewStore()
+	func() {
+		defer func() { recover() }()
+		store.Update(func(tx *Txn) error {
+			panic("boom")
+		})
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		store.Update(func(tx *Txn) error { return nil })
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("store still locked after a panicking update")
+	}
+}
+
+func TestSnapshotIsIndependent(t *testing.T) {
+	bracket, err := NewBracketWithPlayers([]Player{{Name: "A"}, {Name: "B"}, {Name: "C"}, {Name: "D"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	live := Tournament{Bracket: bracket, Courts: NewCourtManager(bracket, 1)}
+	snapshot := live.Snapshot()
+
+	match := bracket.MatchesInRound(0)[0]
+	if err := bracket.RecordResult(match.ID, match.Player1.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := bracket.RenamePlayer(match.Player1.ID, "Renamed"); err != nil {
+		t.Fatal(err)
+	}
+
+	copied, _ := snapshot.Bracket.MatchByID(match.ID)
+	if copied.Winner != nil || copied.Status != MatchReady {
+		t.Errorf("snapshot match is %s with winner %v, want ready and undecided", copied.Status, copied.Winner)
+	}
+	if copied.Player1.Name == "Renamed" {
+		t.Error("snapshot match still points at the live players")
+	}
+	if snapshot.Courts.bracket != snapshot.Bracket {
+		t.Error("snapshot courts follow the live bracket")
+	}
+}
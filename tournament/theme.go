@@ -0,0 +1,14 @@
+package tournament
+
+import "go-tournament/theme"
+
+func init() {
+	SetTheme(theme.Dark)
+}
+
+// SetTheme styles every tournament screen in the theme's colours. Styles are
+// shared by all models, so it applies to every session.
+func SetTheme(t theme.Theme) {
+	applySingleEliminationTheme(t)
+	applyBracketTheme(t)
+}
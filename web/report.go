@@ -0,0 +1,156 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-tournament/tournament"
+)
+
+// reportRequest is the body of a result report.
+type reportRequest struct {
+	WinnerID int `json:"winnerId"`
+}
+
+// reportResponse acknowledges a queued report.
+type reportResponse struct {
+	ReportID   int       `json:"reportId"`
+	MatchID    int       `json:"matchId"`
+	WinnerID   int       `json:"winnerId"`
+	Conflict   bool      `json:"conflict"`
+	ReceivedAt time.Time `json:"receivedAt"`
+	Status     string    `json:"status"`
+}
+
+// errorResponse is the body of every failed API request.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+var (
+	errMissingToken   = errors.New("missing or invalid bearer token")
+	errUnknownMatch   = errors.New("match not found")
+	errMatchDecided   = errors.New("match already has a result")
+	errMatchNotReady  = errors.New("match does not have both players yet")
+	errNotYourMatch   = errors.New("you are not playing in this match")
+	errInvalidWinner  = errors.New("winner is not playing in this match")
+	errNotStarted     = errors.New("tournament has not started")
+	errInvalidPayload = errors.New(`body must be JSON like {"winnerId": 3}`)
+)
+
+// EnableReporting registers POST /api/matches/{id}/report, which lets players
+// report their own results using their bearer token. Reports are queued for the
+// organizer to approve; nothing is applied to the bracket here.
+func (s *Server) EnableReporting(queue *tournament.ReportQueue, tokens *tournament.PlayerTokens) {
+	s.mux.HandleFunc("POST /api/matches/{id}/report", func(w http.ResponseWriter, r *http.Request) {
+		s.handleReport(w, r, queue, tokens)
+	})
+}
+
+func (s *Server) handleReport(w http.ResponseWriter, r *http.Request, queue *tournament.ReportQueue, tokens *tournament.PlayerTokens) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		writeError(w, http.StatusUnauthorized, errMissingToken)
+		return
+	}
+	reporterID, ok := tokens.PlayerID(strings.TrimSpace(token))
+	if !ok {
+		writeError(w, http.StatusUnauthorized, errMissingToken)
+		return
+	}
+
+	matchID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, errUnknownMatch)
+		return
+	}
+
+	var req reportRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<10)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, errInvalidPayload)
+		return
+	}
+
+	s.mu.RLock()
+	view := s.view
+	s.mu.RUnlock()
+
+	if status, err := validateReport(view, matchID, reporterID, req.WinnerID); err != nil {
+		writeError(w, status, err)
+		return
+	}
+
+	report := queue.Submit(matchID, reporterID, req.WinnerID)
+	writeJSON(w, http.StatusAccepted, reportResponse{
+		ReportID:   report.ID,
+		MatchID:    report.MatchID,
+		WinnerID:   report.WinnerID,
+		Conflict:   report.Conflict,
+		ReceivedAt: report.ReceivedAt,
+		Status:     "pending approval",
+	})
+}
+
+// validateReport checks a report against the latest snapshot and returns the
+// HTTP status to use when it is rejected. The organizer's approval re-checks
+// against the live bracket.
+func validateReport(view BracketView, matchID, reporterID, winnerID int) (int, error) {
+	if !view.Started {
+		return http.StatusConflict, errNotStarted
+	}
+
+	match, ok := view.findMatch(matchID)
+	if !ok {
+		return http.StatusNotFound, errUnknownMatch
+	}
+
+	switch match.Status {
+	case tournament.MatchCompleted.String(), tournament.MatchWalkover.String(), tournament.MatchBye.String():
+		return http.StatusConflict, errMatchDecided
+	case tournament.MatchScheduled.String():
+		return http.StatusConflict, errMatchNotReady
+	}
+
+	if !match.hasPlayer(reporterID) {
+		return http.StatusForbidden, errNotYourMatch
+	}
+	if !match.hasPlayer(winnerID) {
+		return http.StatusBadRequest, errInvalidWinner
+	}
+	return http.StatusAccepted, nil
+}
+
+// findMatch returns the match with the given ID from any round.
+func (v BracketView) findMatch(matchID int) (MatchView, bool) {
+	for _, round := range v.Rounds {
+		for _, match := range round.Matches {
+			if match.ID == matchID {
+				return match, true
+			}
+		}
+	}
+	if v.ThirdPlace != nil && v.ThirdPlace.ID == matchID {
+		return *v.ThirdPlace, true
+	}
+	return MatchView{}, false
+}
+
+// hasPlayer reports whether playerID is one of the match's known players.
+func (m MatchView) hasPlayer(playerID int) bool {
+	return (m.Player1 != nil && m.Player1.ID == playerID) ||
+		(m.Player2 != nil && m.Player2.ID == playerID)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
@@ -0,0 +1,228 @@
+// Package web serves the live tournament on the LAN: a read-only bracket for
+// spectators and, when enabled, a result reporting API for players.
+// Pages, styles and scripts are embedded so it works without internet access.
+package web
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go-tournament/tournament"
+)
+
+// sseHeartbeat keeps idle event streams from being closed by phones and proxies.
+const sseHeartbeat = 15 * time.Second
+
+//go:embed static/index.html
+var indexHTML []byte
+
+// Server serves the bracket page, a JSON endpoint and a Server-Sent Events stream.
+// Brackets are snapshotted when published; handlers only ever see snapshots.
+type Server struct {
+	mux *http.ServeMux
+
+	mu          sync.RWMutex
+	view        BracketView
+	snapshot    []byte // JSON-encoded view
+	subscribers map[chan []byte]struct{}
+	onError     func(error)
+}
+
+// NewServer creates a server with an empty, not-started bracket.
+func NewServer() *Server {
+	s := &Server{
+		mux:         http.NewServeMux(),
+		subscribers: make(map[chan []byte]struct{}),
+	}
+	s.view = NewBracketView(nil)
+	s.snapshot = mustMarshal(s.view)
+
+	s.mux.HandleFunc("GET /{$}", s.handleIndex)
+	s.mux.HandleFunc("GET /api/bracket", s.handleBracket)
+	s.mux.HandleFunc("GET /events", s.handleEvents)
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Follow publishes the store's bracket now and after every change to it.
+// Call the returned function to stop following.
+func (s *Server) Follow(store *tournament.Store) (stop func()) {
+	publish := func() {
+		store.View(func(t tournament.Tournament) {
+			s.Publish(t.Bracket)
+		})
+	}
+	stop = store.Subscribe(func(tournament.Event) { publish() })
+	publish()
+	return stop
+}
+
+// Publish snapshots the bracket and pushes it to every connected spectator.
+// The bracket must not change while it is being snapshotted, e.g. by calling
+// it from a Store's View.
+func (s *Server) Publish(bracket *tournament.Bracket) {
+	view := NewBracketView(bracket)
+	data := mustMarshal(view)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.view = view
+	s.snapshot = data
+	for ch := range s.subscribers {
+		// Drop the update for slow clients; they catch up on the next one
+		select {
+		case ch <- data:
+		default:
+		}
+	}
+}
+
+// OnError registers a callback for a server that stopped serving after Listen
+// returned, e.g. to tell the organizer.
+func (s *Server) OnError(fn func(error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onError = fn
+}
+
+// Listen opens addr and serves in the background. It returns once the socket
+// is bound so address errors are reported before the TUI takes the terminal;
+// later errors go to the OnError callback.
+func (s *Server) Listen(addr string) (net.Listener, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	srv := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		err := srv.Serve(ln)
+		if errors.Is(err, http.ErrServerClosed) {
+			return
+		}
+		s.mu.RLock()
+		onError := s.onError
+		s.mu.RUnlock()
+		if onError != nil {
+			onError(err)
+		}
+	}()
+
+	return ln, nil
+}
+
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(indexHTML)
+}
+
+func (s *Server) handleBracket(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	data := s.snapshot
+	s.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(data)
+}
+
+// handleEvents streams a "bracket" event with the current snapshot on connect
+// and again whenever Publish is called.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	ch := make(chan []byte, 1)
+	s.mu.Lock()
+	s.subscribers[ch] = struct{}{}
+	current := s.snapshot
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.subscribers, ch)
+		s.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Connection", "keep-alive")
+
+	writeEvent(w, current)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case data := <-ch:
+			writeEvent(w, data)
+			flusher.Flush()
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+// writeEvent writes one SSE message. Snapshots are single-line JSON, so a
+// single data field is enough.
+func writeEvent(w http.ResponseWriter, data []byte) {
+	fmt.Fprintf(w, "event: bracket\ndata: %s\n\n", data)
+}
+
+// LANURLs returns http URLs for addr on every non-loopback IPv4 interface,
+// which is what spectators' phones need to connect to.
+func LANURLs(addr net.Addr) []string {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return nil
+	}
+
+	interfaceAddrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil
+	}
+
+	var urls []string
+	for _, a := range interfaceAddrs {
+		ipNet, ok := a.(*net.IPNet)
+		if !ok || ipNet.IP.IsLoopback() || ipNet.IP.To4() == nil {
+			continue
+		}
+		urls = append(urls, fmt.Sprintf("http://%s:%d", ipNet.IP, tcpAddr.Port))
+	}
+	if len(urls) == 0 {
+		urls = append(urls, fmt.Sprintf("http://localhost:%d", tcpAddr.Port))
+	}
+	return urls
+}
+
+// mustMarshal encodes a view. BracketView has only plain fields, so encoding cannot fail.
+func mustMarshal(view BracketView) []byte {
+	data, err := json.Marshal(view)
+	if err != nil {
+		panic(fmt.Sprintf("encode bracket view: %v", err))
+	}
+	return data
+}
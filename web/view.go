@@ -0,0 +1,109 @@
+package web
+
+import (
+	"time"
+
+	"go-tournament/i18n"
+	"go-tournament/tournament"
+)
+
+// PlayerView is the public view of a player.
+type PlayerView struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Seed int    `json:"seed"`
+}
+
+// MatchView is the public view of a match.
+type MatchView struct {
+	ID           int         `json:"id"`
+	Round        int         `json:"round"`
+	Position     int         `json:"position"`
+	Player1      *PlayerView `json:"player1"`
+	Player2      *PlayerView `json:"player2"`
+	Winner       *PlayerView `json:"winner"`
+	Status       string      `json:"status"`
+	IsBye        bool        `json:"isBye"`
+	IsThirdPlace bool        `json:"isThirdPlace"`
+}
+
+// RoundView is one column of the bracket.
+type RoundView struct {
+	Name    string      `json:"name"`
+	Matches []MatchView `json:"matches"`
+}
+
+// BracketView is an immutable snapshot of a bracket for spectators.
+// It is built on the TUI goroutine so handlers never touch the live bracket.
+type BracketView struct {
+	Started      bool        `json:"started"`
+	Participants int         `json:"participants"`
+	Rounds       []RoundView `json:"rounds"`
+	ThirdPlace   *MatchView  `json:"thirdPlace"`
+	IsComplete   bool        `json:"isComplete"`
+	Champion     *PlayerView `json:"champion"`
+	UpdatedAt    time.Time   `json:"updatedAt"`
+}
+
+// NewBracketView snapshots a bracket. A nil bracket yields a not-started view.
+func NewBracketView(bracket *tournament.Bracket) BracketView {
+	view := BracketView{UpdatedAt: time.Now()}
+	if bracket == nil {
+		return view
+	}
+
+	view.Started = true
+	view.Participants = len(bracket.Participants)
+	view.IsComplete = bracket.IsComplete
+
+	for round := 0; round < bracket.TotalRounds; round++ {
+		roundView := RoundView{Name: roundName(round, bracket.TotalRounds)}
+		for _, match := range bracket.MatchesInRound(round) {
+			roundView.Matches = append(roundView.Matches, newMatchView(match))
+		}
+		view.Rounds = append(view.Rounds, roundView)
+	}
+
+	if thirdPlace := bracket.ThirdPlaceMatch(); thirdPlace != nil {
+		matchView := newMatchView(thirdPlace)
+		view.ThirdPlace = &matchView
+	}
+	if final := bracket.FinalMatch(); final != nil && bracket.IsComplete {
+		view.Champion = newPlayerView(final.Winner)
+	}
+
+	return view
+}
+
+func newMatchView(match *tournament.Match) MatchView {
+	return MatchView{
+		ID:           match.ID,
+		Round:        match.Round,
+		Position:     match.Position,
+		Player1:      newPlayerView(match.Player1),
+		Player2:      newPlayerView(match.Player2),
+		Winner:       newPlayerView(match.Winner),
+		Status:       match.Status.String(),
+		IsBye:        match.IsBye,
+		IsThirdPlace: match.IsThirdPlace,
+	}
+}
+
+func newPlayerView(player *tournament.Player) *PlayerView {
+	if player == nil {
+		return nil
+	}
+	return &PlayerView{
+		ID:   player.ID,
+		Name: player.Name,
+		Seed: player.Seed,
+	}
+}
+
+// roundName mirrors the TUI's round headers for a 0-indexed round.
+func roundName(round, totalRounds int) string {
+	if name := tournament.GetRoundName(round+1, totalRounds); name != "" {
+		return name
+	}
+	return i18n.T("Round %d", round+1)
+}